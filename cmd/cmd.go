@@ -1,15 +1,23 @@
 package cmd
 
 import (
+	"errors"
 	"github.com/urfave/cli/v2"
+	"micro/domain/migrations"
 	"micro/domain/seeds"
 	"micro/persistence"
 	"micro/pkg/configurator"
+	"micro/pkg/domain/migration"
 	"micro/pkg/domain/registry"
 	"micro/pkg/domain/seed"
 	"micro/pkg/logger"
 	"micro/transport/grpc/server"
+	schedulerDependency "micro/transport/scheduler/dependency"
+	"micro/transport/scheduler/job/documentretention"
+	schedulerServer "micro/transport/scheduler/server"
 	"net/http"
+	"path/filepath"
+	"time"
 )
 
 // NewCli is a constructor will initialize cli.
@@ -23,6 +31,7 @@ func NewCli() *cli.App {
 func NewCommand(
 	config *configurator.Config,
 	registry *registry.Registry,
+	migrator *migration.Migrator,
 	dbClient *persistence.DBClient,
 	httpClient *http.Client,
 	fileStorageClient *persistence.FileStorageClient,
@@ -31,37 +40,142 @@ func NewCommand(
 	return []*cli.Command{
 		{
 			Name:  "db:migrate",
-			Usage: "run database migration",
+			Usage: "apply the pending database migrations",
 			Action: func(c *cli.Context) error {
-				err := registry.AutoMigrate(dbClient.DB)
+				applied, err := migrator.Migrate(dbClient.DB)
+				for _, m := range applied {
+					logger.Log.Infof("Migrated %s_%s", m.Version, m.Name)
+				}
+
+				return err
+			},
+		},
+		{
+			Name:  "db:rollback",
+			Usage: "revert the last applied database migrations",
+			Flags: []cli.Flag{
+				&cli.IntFlag{Name: "steps", Value: 1, Usage: "number of migrations to revert"},
+			},
+			Action: func(c *cli.Context) error {
+				rolledBack, err := migrator.Rollback(dbClient.DB, c.Int("steps"))
+				for _, m := range rolledBack {
+					logger.Log.Infof("Rolled back %s_%s", m.Version, m.Name)
+				}
+
+				return err
+			},
+		},
+		{
+			Name:  "db:status",
+			Usage: "show the status of each database migration",
+			Action: func(c *cli.Context) error {
+				statuses, err := migrator.Status(dbClient.DB)
 				if err != nil {
-					logger.Log.Errorf("Error executing command, err: %v", err)
+					return err
 				}
 
+				printMigrationStatus(statuses)
+
 				return nil
 			},
 		},
 		{
-			Name:  "db:init",
-			Usage: "run predefined database initial seeder",
+			Name:  "db:diff",
+			Usage: "compare the schema of the entities with the live database",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "write", Usage: "write the SQL migration which fixes the drift with the given name"},
+				&cli.StringFlag{Name: "dir", Value: migrations.Dir, Usage: "directory of the Go migrations"},
+			},
 			Action: func(c *cli.Context) error {
-				factory := seeds.NewInitialFactory()
-				seeder := seed.New(factory)
-				err := seeder.Seed(dbClient.DB)
+				var models []interface{}
+				for _, model := range registry.Entities {
+					models = append(models, model.Entity)
+				}
+
+				diffs, err := migration.Diff(dbClient.DB, models)
 				if err != nil {
-					logger.Log.Errorf("Error executing command, err: %v", err)
+					return err
 				}
 
-				return nil
+				printSchemaDiff(diffs)
+
+				name := c.String("write")
+				if name == "" || len(diffs) == 0 {
+					return nil
+				}
+
+				up, down, err := migration.FixSQL(dbClient.DB, diffs)
+				if err != nil {
+					return err
+				}
+
+				// The statements follow the dialect of the live database, so they are written to the files of its driver.
+				sqlDir := filepath.Join(c.String("dir"), "sql")
+				paths, err := migration.WriteSQL(sqlDir, name, dbClient.DB.Dialector.Name(), time.Now(), up, down)
+				for _, path := range paths {
+					logger.Log.Infof("Created %s", path)
+				}
+
+				return err
+			},
+		},
+		{
+			Name:      "make:migration",
+			Usage:     "create a new database migration",
+			ArgsUsage: "<name>",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{Name: "go", Usage: "create a Go migration instead of SQL migration"},
+				&cli.StringFlag{Name: "dir", Value: migrations.Dir, Usage: "directory of the Go migrations"},
+			},
+			Action: func(c *cli.Context) error {
+				name := c.Args().First()
+				if name == "" {
+					return errors.New("migration name is required")
+				}
+
+				if c.Bool("go") {
+					path, err := migrations.CreateGo(c.String("dir"), name, time.Now())
+					if err != nil {
+						return err
+					}
+
+					logger.Log.Infof("Created %s", path)
+					return nil
+				}
+
+				paths, err := migration.CreateSQL(filepath.Join(c.String("dir"), "sql"), name, time.Now())
+				for _, path := range paths {
+					logger.Log.Infof("Created %s", path)
+				}
+
+				return err
+			},
+		},
+		{
+			Name:  "db:init",
+			Usage: "run only the database seeders of the default fixtures, which are needed by every environment",
+			Action: func(c *cli.Context) error {
+				return runSeeds(dbClient, fileStorageClient, logger, seed.WithEnv(seeds.DefaultEnv))
+			},
+		},
+		{
+			Name:  "db:seed",
+			Usage: "run the database seeders which are not applied yet",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "name", Usage: "run only the seeder with the given name, e.g. dev/documents"},
+				&cli.StringFlag{Name: "env", Value: config.AppEnvironment, Usage: "run the seeders of the given environment"},
+			},
+			Action: func(c *cli.Context) error {
+				return runSeeds(dbClient, fileStorageClient, logger, seed.WithName(c.String("name")), seed.WithEnv(c.String("env")))
 			},
 		},
 		{
 			Name:  "grpc:start",
 			Usage: "Start the grpc server",
 			Action: func(c *cli.Context) error {
-				err := registry.AutoMigrate(dbClient.DB)
+				err := CheckMigration(config, migrator, dbClient.DB, logger)
 				if err != nil {
-					logger.Log.Errorf("Error executing command, err: %v", err)
+					return err
 				}
 
 				grpcServer := server.New(
@@ -79,5 +193,51 @@ func NewCommand(
 				return nil
 			},
 		},
+		{
+			Name:  "scheduler:start",
+			Usage: "Start the scheduler to run periodic jobs",
+			Action: func(c *cli.Context) error {
+				err := CheckMigration(config, migrator, dbClient.DB, logger)
+				if err != nil {
+					return err
+				}
+
+				jobServer := schedulerServer.New(
+					schedulerServer.WithConfig(config),
+					schedulerServer.WithLogger(logger),
+					schedulerServer.WithDBClient(dbClient),
+					schedulerServer.WithFileStorageClient(fileStorageClient),
+				)
+
+				errRun := jobServer.Init()
+				if errRun != nil {
+					return errRun
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "document:purge",
+			Usage: "purge expired and soft-deleted documents based on category retention policy",
+			Action: func(c *cli.Context) error {
+				handler := &documentretention.Handler{
+					Dependency: &schedulerDependency.Dependency{
+						Config:            config,
+						Logger:            logger,
+						DBClient:          dbClient,
+						FileStorageClient: fileStorageClient,
+					},
+				}
+
+				return handler.Purge(c.Context)
+			},
+		},
+		{
+			Name:  "storage:recalculate",
+			Usage: "recalculate used bytes and objects of each document category from its documents",
+			Action: func(c *cli.Context) error {
+				return dbClient.StorageUsage.RecalculateStorageUsages(c.Context)
+			},
+		},
 	}
 }
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"micro/pkg/configurator"
+	"micro/pkg/domain/migration"
+	"micro/pkg/logger"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CheckMigration will return error when the database has a pending migration, so the server refuses to start
+// on the schema it does not expect. The pending migration is only logged when it is allowed by the config.
+func CheckMigration(config *configurator.Config, migrator *migration.Migrator, db *gorm.DB, logger *logger.Logger) error {
+	err := migrator.CheckPending(db)
+	if err == nil {
+		return nil
+	}
+
+	if config.DBConfig.AllowPendingMigration {
+		logger.Log.Warnf("Starting with pending migration, err: %v", err)
+		return nil
+	}
+
+	return fmt.Errorf("%w, run db:migrate or set DB_ALLOW_PENDING_MIGRATION=true", err)
+}
+
+// printMigrationStatus will print the status of each migration as a table.
+func printMigrationStatus(statuses []migration.Status) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", status.Version, status.Name, appliedAt)
+	}
+
+	_ = w.Flush()
+}
+
+// printSchemaDiff will print the differences between the entities and the database as a table.
+func printSchemaDiff(diffs []migration.Difference) {
+	if len(diffs) == 0 {
+		fmt.Println("No schema drift.")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "TABLE\tKIND\tNAME\tEXPECTED\tACTUAL")
+	for _, diff := range diffs {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", diff.Table, diff.Kind, diff.Name, diff.Expected, diff.Actual)
+	}
+
+	_ = w.Flush()
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"micro/domain/seeds"
+	"micro/persistence"
+	"micro/pkg/domain/seed"
+	"micro/pkg/filestore"
+	"micro/pkg/logger"
+)
+
+// runSeeds will run the seeds of the fixtures which match the options and are not applied yet.
+func runSeeds(dbClient *persistence.DBClient, fileStorageClient *persistence.FileStorageClient, logger *logger.Logger, opts ...seed.Option) error {
+	var fileStorage filestore.Interface
+	if fileStorageClient != nil {
+		fileStorage = fileStorageClient.Driver
+	}
+
+	fixtures, err := seeds.NewSeeds(fileStorage)
+	if err != nil {
+		return err
+	}
+
+	applied, err := seed.New(fixtures).Seed(dbClient.DB, opts...)
+	for _, s := range applied {
+		logger.Log.Infof("Seeded %s", s.Name)
+	}
+
+	return err
+}
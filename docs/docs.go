@@ -235,8 +235,17 @@ const docTemplate = `{
                 "field": {
                     "type": "string"
                 },
+                "limit": {
+                    "type": "integer"
+                },
                 "quota": {
                     "type": "string"
+                },
+                "requested": {
+                    "type": "integer"
+                },
+                "used": {
+                    "type": "integer"
                 }
             }
         },
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"micro/pkg/parameter"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,17 +10,22 @@ import (
 
 // Document represent schema of table Documents.
 type Document struct {
-	ID           string    `gorm:"size:36;not null;uniqueIndex;primary_key;"`
-	CategoryID   string    `gorm:"size:36;not null;index;"`
-	OriginalName string    `gorm:"size:255;not null;"`
-	Name         string    `gorm:"size:255;not null;"`
-	Path         string    `gorm:"size:255;not null;"`
-	Type         string    `gorm:"size:36;not null;"`
-	Size         int64     `gorm:"not null;"`
-	Token        string    `gorm:"size:300;"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
-	DeletedAt    gorm.DeletedAt
+	ID              string `gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	CategoryID      string `gorm:"size:36;not null;index;"`
+	OriginalName    string `gorm:"size:255;not null;"`
+	Name            string `gorm:"size:255;not null;"`
+	Path            string `gorm:"size:255;not null;"`
+	Type            string `gorm:"size:36;not null;"`
+	Size            int64  `gorm:"not null;"`
+	Token           string `gorm:"size:300;"`
+	LegalHold       bool   `gorm:"not null;default:false;index;"`
+	LegalHoldReason string `gorm:"size:255;"`
+	LegalHoldBy     string `gorm:"size:100;"`
+	LegalHoldAt     *time.Time
+	Version         int64     `gorm:"not null;default:1;"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt
 }
 
 var _ Interface = &DocumentCategory{}
@@ -34,7 +40,15 @@ func (f *Document) TableName() string {
 
 // FilterableFields return fields.
 func (f *Document) FilterableFields() []interface{} {
-	return []interface{}{"name", "slug"}
+	return []interface{}{"category_id", "name", "original_name", "type", "size", "created_at"}
+}
+
+// FilterableFieldTypes return types of the filterable fields, the field which is not listed is a string.
+func (f *Document) FilterableFieldTypes() map[string]parameter.FieldType {
+	return map[string]parameter.FieldType{
+		"size":       parameter.FieldTypeNumber,
+		"created_at": parameter.FieldTypeTime,
+	}
 }
 
 // TimeFields return fields.
@@ -42,7 +56,29 @@ func (f *Document) TimeFields() []interface{} {
 	return []interface{}{"created_at", "updated_at", "deleted_at"}
 }
 
-// BeforeCreate handle uuid generation.
+// SortableFields return fields, the nullable deleted_at is not sortable because the cursor cannot page through NULL.
+func (f *Document) SortableFields() []interface{} {
+	return []interface{}{"name", "original_name", "type", "size", "created_at", "updated_at"}
+}
+
+// SelectableFields return fields of the response and the columns needed to build them.
+func (f *Document) SelectableFields() map[string][]string {
+	return map[string][]string{
+		"id":            {"id"},
+		"category_id":   {"category_id"},
+		"original_name": {"original_name"},
+		"name":          {"name"},
+		"path":          {"path"},
+		"type":          {"type"},
+		"size":          {"size"},
+		"legal_hold":    {"legal_hold"},
+		"version":       {"version"},
+		"created_at":    {"created_at"},
+		"deleted_at":    {"deleted_at"},
+	}
+}
+
+// BeforeCreate handle uuid generation and the first version.
 func (f *Document) BeforeCreate(tx *gorm.DB) error {
 	generateUUID := uuid.New()
 	if f.ID == "" {
@@ -58,5 +94,9 @@ func (f *Document) BeforeCreate(tx *gorm.DB) error {
 	if f.UpdatedAt == defaultTime {
 		f.UpdatedAt = time.Now()
 	}
+
+	if f.Version == 0 {
+		f.Version = 1
+	}
 	return nil
 }
@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"micro/pkg/parameter"
+	"micro/pkg/util"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,17 +10,29 @@ import (
 	"gorm.io/gorm"
 )
 
+// DocumentCategorySlugIndex is the unique index of the slug, it ignores the soft-deleted categories.
+const DocumentCategorySlugIndex = "idx_document_categories_slug_active"
+
 // DocumentCategory represent schema of table categories.
 type DocumentCategory struct {
-	ID          string    `gorm:"size:36;not null;unique_index;primary_key"`
-	Slug        string    `gorm:"size:100;not null;index;"`
-	Name        string    `gorm:"size:100;not null;index;"`
-	Description string    `gorm:"size:255;not null;"`
-	MimeTypes   string    `gorm:"size:255;not null;"`
-	Size        float64   `gorm:"bigint;not null;"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt
+	ID                    string `gorm:"size:36;not null;unique_index;primary_key"`
+	Slug                  string `gorm:"size:100;not null;index;"`
+	Name                  string `gorm:"size:100;not null;index;"`
+	Description           string `gorm:"size:255;not null;"`
+	MimeTypes             string `gorm:"size:255;not null;"`
+	Size                  int64  `gorm:"type:bigint;not null;"`
+	PurgeDeletedAfterDays int    `gorm:"not null;default:0;"`
+	ExpireActiveAfterDays int    `gorm:"not null;default:0;"`
+	QuotaBytes            int64  `gorm:"not null;default:0;"`
+	QuotaObjects          int64  `gorm:"not null;default:0;"`
+	LegalHold             bool   `gorm:"not null;default:false;index;"`
+	LegalHoldReason       string `gorm:"size:255;"`
+	LegalHoldBy           string `gorm:"size:100;"`
+	LegalHoldAt           *time.Time
+	Version               int64     `gorm:"not null;default:1;"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+	DeletedAt             gorm.DeletedAt
 }
 
 var _ Interface = &DocumentCategory{}
@@ -33,7 +47,16 @@ func (fc *DocumentCategory) TableName() string {
 
 // FilterableFields return fields.
 func (fc *DocumentCategory) FilterableFields() []interface{} {
-	return []interface{}{"name", "slug"}
+	return []interface{}{"name", "slug", "size", "legal_hold", "created_at"}
+}
+
+// FilterableFieldTypes return types of the filterable fields, the field which is not listed is a string.
+func (fc *DocumentCategory) FilterableFieldTypes() map[string]parameter.FieldType {
+	return map[string]parameter.FieldType{
+		"size":       parameter.FieldTypeNumber,
+		"legal_hold": parameter.FieldTypeBool,
+		"created_at": parameter.FieldTypeTime,
+	}
 }
 
 // TimeFields return fields.
@@ -41,11 +64,103 @@ func (fc *DocumentCategory) TimeFields() []interface{} {
 	return []interface{}{"created_at", "updated_at", "deleted_at"}
 }
 
-// BeforeCreate handle uuid generation.
+// SortableFields return fields, the nullable deleted_at is not sortable because the cursor cannot page through NULL.
+func (fc *DocumentCategory) SortableFields() []interface{} {
+	return []interface{}{"name", "slug", "size", "created_at", "updated_at"}
+}
+
+// SelectableFields return fields of the response and the columns needed to build them.
+func (fc *DocumentCategory) SelectableFields() map[string][]string {
+	return map[string][]string{
+		"id":                       {"id"},
+		"name":                     {"name"},
+		"slug":                     {"slug"},
+		"size":                     {"size"},
+		"size_bytes":               {"size"},
+		"size_formatted":           {"size"},
+		"mime_types":               {"mime_types"},
+		"desc":                     {"description"},
+		"purge_deleted_after_days": {"purge_deleted_after_days"},
+		"expire_active_after_days": {"expire_active_after_days"},
+		"quota_bytes":              {"quota_bytes"},
+		"quota_objects":            {"quota_objects"},
+		"legal_hold":               {"legal_hold"},
+		"legal_hold_reason":        {"legal_hold_reason"},
+		"legal_hold_by":            {"legal_hold_by"},
+		"legal_hold_at":            {"legal_hold_at"},
+		"version":                  {"version"},
+		"created_at":               {"created_at"},
+		"deleted_at":               {"deleted_at"},
+	}
+}
+
+// BeforeCreate handle uuid generation and the first version.
 func (fc *DocumentCategory) BeforeCreate(tx *gorm.DB) error {
 	generateUUID := uuid.New()
 	if fc.ID == "" {
 		fc.ID = generateUUID.String()
 	}
+
+	if fc.Version == 0 {
+		fc.Version = 1
+	}
 	return nil
 }
+
+// HasRetentionPolicy return true when at least one retention policy is enabled.
+func (fc *DocumentCategory) HasRetentionPolicy() bool {
+	return fc.PurgeDeletedAfterDays > 0 || fc.ExpireActiveAfterDays > 0
+}
+
+// AfterAutoMigrate create the unique index of the slug when it is missing.
+// The index ignores the soft-deleted categories, so a slug is reused after its category is deleted. The gorm index
+// tag can not describe it, because MySQL has no partial index, the slug of the deleted category is indexed as NULL
+// by a functional index instead.
+func (fc *DocumentCategory) AfterAutoMigrate(db *gorm.DB) error {
+	if db.Migrator().HasIndex(fc, DocumentCategorySlugIndex) {
+		return nil
+	}
+
+	if db.Dialector.Name() == "mysql" {
+		return db.Exec("CREATE UNIQUE INDEX " + DocumentCategorySlugIndex + " ON " + fc.TableName() +
+			" ((IF(deleted_at IS NULL, slug, NULL)))").Error
+	}
+
+	return db.Exec("CREATE UNIQUE INDEX " + DocumentCategorySlugIndex + " ON " + fc.TableName() +
+		" (slug) WHERE deleted_at IS NULL").Error
+}
+
+// ExtraIndexes return the indexes which are created by AfterAutoMigrate instead of the gorm index tag.
+func (fc *DocumentCategory) ExtraIndexes() []string {
+	return []string{DocumentCategorySlugIndex}
+}
+
+// SizeFormatted return the human readable size limit, e.g. 10 MB.
+func (fc *DocumentCategory) SizeFormatted() string {
+	return util.ByteSize(uint64(fc.Size))
+}
+
+// HasStorageQuota return true when at least one storage quota is enabled.
+func (fc *DocumentCategory) HasStorageQuota() bool {
+	return fc.QuotaBytes > 0 || fc.QuotaObjects > 0
+}
+
+// PurgeDeletedBefore return the time before which soft-deleted documents must be purged.
+// The second return value is false when the policy is disabled.
+func (fc *DocumentCategory) PurgeDeletedBefore(now time.Time) (time.Time, bool) {
+	if fc.PurgeDeletedAfterDays <= 0 {
+		return time.Time{}, false
+	}
+
+	return now.AddDate(0, 0, -fc.PurgeDeletedAfterDays), true
+}
+
+// ExpireActiveBefore return the time before which active documents are expired and must be purged.
+// The second return value is false when the policy is disabled.
+func (fc *DocumentCategory) ExpireActiveBefore(now time.Time) (time.Time, bool) {
+	if fc.ExpireActiveAfterDays <= 0 {
+		return time.Time{}, false
+	}
+
+	return now.AddDate(0, 0, -fc.ExpireActiveAfterDays), true
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"micro/pkg/parameter"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// PurgeReasonDeleted represent documents purged because they were soft-deleted longer than the retention.
+	PurgeReasonDeleted = "deleted"
+
+	// PurgeReasonExpired represent documents purged because they were active longer than the retention.
+	PurgeReasonExpired = "expired"
+)
+
+// DocumentPurgeReport represent schema of table document_purge_reports.
+// A report is written for every category and reason processed by the retention purge job.
+type DocumentPurgeReport struct {
+	ID                string    `gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	CategoryID        string    `gorm:"size:36;not null;index;"`
+	Reason            string    `gorm:"size:36;not null;index;"`
+	Cutoff            time.Time `gorm:"not null;"`
+	TotalPurged       int64     `gorm:"not null;default:0;"`
+	TotalFailed       int64     `gorm:"not null;default:0;"`
+	PurgedBytes       int64     `gorm:"not null;default:0;"`
+	FailedDocumentIDs string    `gorm:"type:text;"`
+	StartedAt         time.Time `gorm:"not null;"`
+	FinishedAt        time.Time `gorm:"not null;"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+var _ Interface = &DocumentPurgeReport{}
+
+// DocumentPurgeReports represent multiple DocumentPurgeReport.
+type DocumentPurgeReports []*DocumentPurgeReport
+
+// TableName return name of table.
+func (r *DocumentPurgeReport) TableName() string {
+	return "document_purge_reports"
+}
+
+// FilterableFields return fields.
+func (r *DocumentPurgeReport) FilterableFields() []interface{} {
+	return []interface{}{"category_id", "reason", "total_failed", "started_at"}
+}
+
+// FilterableFieldTypes return types of the filterable fields, the field which is not listed is a string.
+func (r *DocumentPurgeReport) FilterableFieldTypes() map[string]parameter.FieldType {
+	return map[string]parameter.FieldType{
+		"total_failed": parameter.FieldTypeNumber,
+		"started_at":   parameter.FieldTypeTime,
+	}
+}
+
+// TimeFields return fields.
+func (r *DocumentPurgeReport) TimeFields() []interface{} {
+	return []interface{}{"created_at", "updated_at", "started_at", "finished_at"}
+}
+
+// SortableFields return fields.
+func (r *DocumentPurgeReport) SortableFields() []interface{} {
+	return []interface{}{"reason", "total_purged", "purged_bytes", "created_at", "updated_at", "started_at", "finished_at"}
+}
+
+// SelectableFields return fields of the response and the columns needed to build them.
+func (r *DocumentPurgeReport) SelectableFields() map[string][]string {
+	return map[string][]string{
+		"id":                  {"id"},
+		"category_id":         {"category_id"},
+		"reason":              {"reason"},
+		"cutoff":              {"cutoff"},
+		"total_purged":        {"total_purged"},
+		"total_failed":        {"total_failed"},
+		"purged_bytes":        {"purged_bytes"},
+		"failed_document_ids": {"failed_document_ids"},
+		"started_at":          {"started_at"},
+		"finished_at":         {"finished_at"},
+		"created_at":          {"created_at"},
+	}
+}
+
+// BeforeCreate handle uuid generation.
+func (r *DocumentPurgeReport) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if r.ID == "" {
+		r.ID = generateUUID.String()
+	}
+	return nil
+}
@@ -1,8 +1,13 @@
 package entity
 
+import "micro/pkg/parameter"
+
 // Interface is a sets of function need to be implements by each entity.
 type Interface interface {
 	TableName() string
 	FilterableFields() []interface{}
+	FilterableFieldTypes() map[string]parameter.FieldType
 	TimeFields() []interface{}
+	SortableFields() []interface{}
+	SelectableFields() map[string][]string
 }
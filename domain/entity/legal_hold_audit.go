@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"micro/pkg/parameter"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// LegalHoldSubjectDocument represent a legal hold placed on a single Document.
+	LegalHoldSubjectDocument = "document"
+
+	// LegalHoldSubjectDocumentCategory represent a legal hold placed on a whole DocumentCategory.
+	LegalHoldSubjectDocumentCategory = "document_category"
+
+	// LegalHoldActionPlace represent an audit of placing a legal hold.
+	LegalHoldActionPlace = "place"
+
+	// LegalHoldActionRelease represent an audit of releasing a legal hold.
+	LegalHoldActionRelease = "release"
+)
+
+// LegalHoldAudit represent schema of table legal_hold_audits.
+// Every legal hold placement and release is recorded as an audit.
+type LegalHoldAudit struct {
+	ID          string    `gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	SubjectType string    `gorm:"size:36;not null;index:idx_legal_hold_audits_subject;"`
+	SubjectID   string    `gorm:"size:36;not null;index:idx_legal_hold_audits_subject;"`
+	Action      string    `gorm:"size:36;not null;"`
+	Reason      string    `gorm:"size:255;not null;"`
+	Actor       string    `gorm:"size:100;not null;"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+var _ Interface = &LegalHoldAudit{}
+
+// LegalHoldAudits represent multiple LegalHoldAudit.
+type LegalHoldAudits []*LegalHoldAudit
+
+// TableName return name of table.
+func (a *LegalHoldAudit) TableName() string {
+	return "legal_hold_audits"
+}
+
+// FilterableFields return fields.
+func (a *LegalHoldAudit) FilterableFields() []interface{} {
+	return []interface{}{"subject_type", "subject_id", "action", "actor", "created_at"}
+}
+
+// FilterableFieldTypes return types of the filterable fields, the field which is not listed is a string.
+func (a *LegalHoldAudit) FilterableFieldTypes() map[string]parameter.FieldType {
+	return map[string]parameter.FieldType{
+		"created_at": parameter.FieldTypeTime,
+	}
+}
+
+// TimeFields return fields.
+func (a *LegalHoldAudit) TimeFields() []interface{} {
+	return []interface{}{"created_at"}
+}
+
+// SortableFields return fields.
+func (a *LegalHoldAudit) SortableFields() []interface{} {
+	return []interface{}{"subject_type", "action", "actor", "created_at"}
+}
+
+// SelectableFields return fields of the response and the columns needed to build them.
+func (a *LegalHoldAudit) SelectableFields() map[string][]string {
+	return map[string][]string{
+		"id":           {"id"},
+		"subject_type": {"subject_type"},
+		"subject_id":   {"subject_id"},
+		"action":       {"action"},
+		"reason":       {"reason"},
+		"actor":        {"actor"},
+		"created_at":   {"created_at"},
+	}
+}
+
+// BeforeCreate handle uuid generation.
+func (a *LegalHoldAudit) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if a.ID == "" {
+		a.ID = generateUUID.String()
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"micro/pkg/parameter"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// StorageSubjectDocumentCategory represent the storage usage of a whole DocumentCategory.
+	StorageSubjectDocumentCategory = "document_category"
+
+	// StorageQuotaBytes represent the quota of total stored bytes.
+	StorageQuotaBytes = "bytes"
+
+	// StorageQuotaObjects represent the quota of total stored objects.
+	StorageQuotaObjects = "objects"
+)
+
+// StorageUsage represent schema of table storage_usages.
+// It tracks the used bytes and objects of a subject, e.g. a DocumentCategory, and can be
+// extended to other subjects (owner or tenant) by adding a new subject type.
+type StorageUsage struct {
+	ID          string    `gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	SubjectType string    `gorm:"size:36;not null;uniqueIndex:idx_storage_usages_subject;"`
+	SubjectID   string    `gorm:"size:36;not null;uniqueIndex:idx_storage_usages_subject;"`
+	UsedBytes   int64     `gorm:"not null;default:0;"`
+	UsedObjects int64     `gorm:"not null;default:0;"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+var _ Interface = &StorageUsage{}
+
+// StorageUsages represent multiple StorageUsage.
+type StorageUsages []*StorageUsage
+
+// TableName return name of table.
+func (u *StorageUsage) TableName() string {
+	return "storage_usages"
+}
+
+// FilterableFields return fields.
+func (u *StorageUsage) FilterableFields() []interface{} {
+	return []interface{}{"subject_type", "subject_id", "used_bytes", "used_objects"}
+}
+
+// FilterableFieldTypes return types of the filterable fields, the field which is not listed is a string.
+func (u *StorageUsage) FilterableFieldTypes() map[string]parameter.FieldType {
+	return map[string]parameter.FieldType{
+		"used_bytes":   parameter.FieldTypeNumber,
+		"used_objects": parameter.FieldTypeNumber,
+	}
+}
+
+// TimeFields return fields.
+func (u *StorageUsage) TimeFields() []interface{} {
+	return []interface{}{"created_at", "updated_at"}
+}
+
+// SortableFields return fields.
+func (u *StorageUsage) SortableFields() []interface{} {
+	return []interface{}{"used_bytes", "used_objects", "created_at", "updated_at"}
+}
+
+// SelectableFields return fields of the response and the columns needed to build them.
+func (u *StorageUsage) SelectableFields() map[string][]string {
+	return map[string][]string{
+		"id":           {"id"},
+		"subject_type": {"subject_type"},
+		"subject_id":   {"subject_id"},
+		"used_bytes":   {"used_bytes"},
+		"used_objects": {"used_objects"},
+		"created_at":   {"created_at"},
+		"updated_at":   {"updated_at"},
+	}
+}
+
+// BeforeCreate handle uuid generation.
+func (u *StorageUsage) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.ID == "" {
+		u.ID = generateUUID.String()
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"micro/domain/entity"
+	"micro/pkg/filestore/object"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// DocumentBuilder is a builder of entity.Document with the fake data. The type, the size and the path of the
+// document match its content, which is put into the file storage when the document is created.
+type DocumentBuilder struct {
+	factory         *Factory
+	id              string
+	originalName    string
+	content         []byte
+	category        *entity.DocumentCategory
+	categoryBuilder *DocumentCategoryBuilder
+	overrides       []func(document *entity.Document)
+}
+
+// newDocumentBuilder will initialize DocumentBuilder with a unique PDF content and a new category.
+func newDocumentBuilder(f *Factory) *DocumentBuilder {
+	sequence := f.next()
+
+	return &DocumentBuilder{
+		factory:      f,
+		id:           uuid.New().String(),
+		originalName: fmt.Sprintf("document-%d.pdf", sequence),
+		content:      fakePDF(sequence),
+	}
+}
+
+// With is a method uses to override any field of the document, it is applied after the fields of the content.
+// The content is put on the overridden path.
+func (b *DocumentBuilder) With(override func(document *entity.Document)) *DocumentBuilder {
+	b.overrides = append(b.overrides, override)
+
+	return b
+}
+
+// WithCategory is a method uses to set the saved category of the document.
+func (b *DocumentBuilder) WithCategory(category *entity.DocumentCategory) *DocumentBuilder {
+	b.category = category
+	b.categoryBuilder = nil
+
+	return b
+}
+
+// ForCategory is a method uses to set the category builder, the category is created with the document.
+func (b *DocumentBuilder) ForCategory(categoryBuilder *DocumentCategoryBuilder) *DocumentBuilder {
+	b.category = nil
+	b.categoryBuilder = categoryBuilder
+
+	return b
+}
+
+// WithContent is a method uses to set the content of the document.
+func (b *DocumentBuilder) WithContent(content []byte) *DocumentBuilder {
+	b.content = content
+
+	return b
+}
+
+// WithOriginalName is a method uses to set the original name of the document.
+func (b *DocumentBuilder) WithOriginalName(originalName string) *DocumentBuilder {
+	b.originalName = originalName
+
+	return b
+}
+
+// Build return the document without saving it, the category is built without saving when it is not set.
+func (b *DocumentBuilder) Build() *entity.Document {
+	category := b.category
+	if category == nil {
+		category = b.categoryOrNew().Build()
+	}
+
+	document, _ := b.build(category)
+
+	return document
+}
+
+// Create will save the category when it is not saved yet, put the content into the file storage and save the
+// document through the repository, so the storage usage of the category is tracked.
+func (b *DocumentBuilder) Create(ctx context.Context) (*entity.Document, error) {
+	if b.factory.FileStorage == nil {
+		return nil, errors.New("factory: file storage is required to create the document")
+	}
+
+	if b.category == nil {
+		category, err := b.categoryOrNew().Create(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		b.WithCategory(category)
+	}
+
+	document, metadata := b.build(b.category)
+
+	_, err := b.factory.FileStorage.PutObject(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := b.factory.DBClient.Document.SaveDocument(ctx, document)
+	if err != nil {
+		_ = b.factory.FileStorage.DeleteObject(metadata.Filepath())
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// MustCreate will create the document and fail the test on error.
+func (b *DocumentBuilder) MustCreate(t testing.TB) *entity.Document {
+	t.Helper()
+
+	document, err := b.Create(context.Background())
+	if err != nil {
+		t.Fatalf("factory: create document, err: %v", err)
+	}
+
+	return document
+}
+
+// categoryOrNew return the category builder, a new one is used when it is not set.
+func (b *DocumentBuilder) categoryOrNew() *DocumentCategoryBuilder {
+	if b.categoryBuilder == nil {
+		b.categoryBuilder = b.factory.DocumentCategory()
+	}
+
+	return b.categoryBuilder
+}
+
+// build return the document of the category and the object metadata of its content.
+func (b *DocumentBuilder) build(category *entity.DocumentCategory) (*entity.Document, *object.Metadata) {
+	metadata := object.NewFromByteSlice(b.content, category.Slug,
+		object.WithID(b.id),
+		object.WithName(b.id),
+		object.WithOriginalName(b.originalName),
+		object.WithPutMethod(object.DirectPut),
+		object.IncludeSlug(),
+	)
+
+	document := &entity.Document{
+		ID:           metadata.ID,
+		CategoryID:   category.ID,
+		OriginalName: metadata.OriginalName,
+		Name:         metadata.Filename(),
+		Path:         metadata.Filepath(),
+		Type:         metadata.ContentType,
+		Size:         metadata.Size,
+	}
+
+	for _, override := range b.overrides {
+		override(document)
+	}
+	metadata.CustomPath = document.Path
+
+	return document, metadata
+}
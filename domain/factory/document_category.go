@@ -0,0 +1,101 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+	"micro/domain/entity"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// DocumentCategoryBuilder is a builder of entity.DocumentCategory with the fake data.
+type DocumentCategoryBuilder struct {
+	factory  *Factory
+	category entity.DocumentCategory
+}
+
+// newDocumentCategoryBuilder will initialize DocumentCategoryBuilder with a unique slug and name.
+func newDocumentCategoryBuilder(f *Factory) *DocumentCategoryBuilder {
+	sequence := f.next()
+
+	return &DocumentCategoryBuilder{
+		factory: f,
+		category: entity.DocumentCategory{
+			ID:          uuid.New().String(),
+			Slug:        fmt.Sprintf("category-%d", sequence),
+			Name:        fmt.Sprintf("Category %d", sequence),
+			Description: fmt.Sprintf("Fake document category %d", sequence),
+			MimeTypes:   "application/pdf",
+			Size:        10 * 1024 * 1024,
+		},
+	}
+}
+
+// With is a method uses to override any field of the category.
+func (b *DocumentCategoryBuilder) With(override func(category *entity.DocumentCategory)) *DocumentCategoryBuilder {
+	override(&b.category)
+
+	return b
+}
+
+// WithSlug is a method uses to set the slug of the category.
+func (b *DocumentCategoryBuilder) WithSlug(slug string) *DocumentCategoryBuilder {
+	b.category.Slug = slug
+
+	return b
+}
+
+// WithMimeTypes is a method uses to set the allowed mime types of the category.
+func (b *DocumentCategoryBuilder) WithMimeTypes(mimeTypes string) *DocumentCategoryBuilder {
+	b.category.MimeTypes = mimeTypes
+
+	return b
+}
+
+// WithSize is a method uses to set the maximum document size of the category.
+func (b *DocumentCategoryBuilder) WithSize(size int64) *DocumentCategoryBuilder {
+	b.category.Size = size
+
+	return b
+}
+
+// WithQuota is a method uses to set the storage quotas of the category.
+func (b *DocumentCategoryBuilder) WithQuota(bytes int64, objects int64) *DocumentCategoryBuilder {
+	b.category.QuotaBytes = bytes
+	b.category.QuotaObjects = objects
+
+	return b
+}
+
+// WithRetention is a method uses to set the retention policy of the category.
+func (b *DocumentCategoryBuilder) WithRetention(purgeDeletedAfterDays int, expireActiveAfterDays int) *DocumentCategoryBuilder {
+	b.category.PurgeDeletedAfterDays = purgeDeletedAfterDays
+	b.category.ExpireActiveAfterDays = expireActiveAfterDays
+
+	return b
+}
+
+// Build return the category without saving it.
+func (b *DocumentCategoryBuilder) Build() *entity.DocumentCategory {
+	category := b.category
+
+	return &category
+}
+
+// Create will save the category through the repository.
+func (b *DocumentCategoryBuilder) Create(ctx context.Context) (*entity.DocumentCategory, error) {
+	return b.factory.DBClient.DocumentCategory.SaveDocumentCategory(ctx, b.Build())
+}
+
+// MustCreate will save the category through the repository and fail the test on error.
+func (b *DocumentCategoryBuilder) MustCreate(t testing.TB) *entity.DocumentCategory {
+	t.Helper()
+
+	category, err := b.Create(context.Background())
+	if err != nil {
+		t.Fatalf("factory: create document category, err: %v", err)
+	}
+
+	return category
+}
@@ -0,0 +1,46 @@
+// Package factory provides the builders of the entities with fake data for the tests, e.g.
+//
+//	document := factory.New(dbClient, memory.NewDriver()).Document().WithOriginalName("invoice.pdf").MustCreate(t)
+//
+// Each builder returns the entity without saving it by Build, or saves it through the repositories by Create.
+package factory
+
+import (
+	"fmt"
+	"micro/persistence"
+	"micro/pkg/filestore"
+	"sync/atomic"
+)
+
+// Factory is a struct uses to holds the dependencies to persist the entities and the sequence of the fake data.
+type Factory struct {
+	DBClient    *persistence.DBClient
+	FileStorage filestore.Interface
+
+	sequence uint64
+}
+
+// New is a constructor will initialize Factory.
+func New(dbClient *persistence.DBClient, fileStorage filestore.Interface) *Factory {
+	return &Factory{DBClient: dbClient, FileStorage: fileStorage}
+}
+
+// DocumentCategory return a new DocumentCategoryBuilder with the fake data.
+func (f *Factory) DocumentCategory() *DocumentCategoryBuilder {
+	return newDocumentCategoryBuilder(f)
+}
+
+// Document return a new DocumentBuilder with the fake data and a new category.
+func (f *Factory) Document() *DocumentBuilder {
+	return newDocumentBuilder(f)
+}
+
+// next return the next sequence, it is used to make the fake data unique.
+func (f *Factory) next() uint64 {
+	return atomic.AddUint64(&f.sequence, 1)
+}
+
+// fakePDF return the bytes of a minimal PDF which is unique by the sequence.
+func fakePDF(sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%%PDF-1.4\n%% factory document %d\n1 0 obj\n<< /Type /Catalog >>\nendobj\ntrailer\n<< /Root 1 0 R >>\n%%%%EOF\n", sequence))
+}
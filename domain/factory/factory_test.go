@@ -0,0 +1,130 @@
+package factory_test
+
+import (
+	"context"
+	"micro/domain/entity"
+	"micro/domain/factory"
+	"micro/domain/registry"
+	"micro/persistence"
+	"micro/pkg/configurator"
+	"micro/pkg/filestore/driver/memory"
+	"micro/pkg/provider/connection"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDBClient return the DBClient of a new SQLite test database which is migrated from the entities.
+func newTestDBClient(t *testing.T) *persistence.DBClient {
+	t.Helper()
+
+	config := &configurator.Config{TestMode: true, MaxIdleCons: 2, MaxOpenCons: 4}
+	config.DBTestConfig = configurator.DBTestConfig{DBDriver: "sqlite", DBName: filepath.Join(t.TempDir(), "micro_test.db")}
+
+	db, err := connection.NewDBConnection(config)
+	require.NoError(t, err)
+	require.NoError(t, registry.NewRegistry().AutoMigrate(db))
+
+	return persistence.NewDBService(db)
+}
+
+func TestFactoryDocumentCategoryBuild(t *testing.T) {
+	f := factory.New(nil, memory.NewDriver())
+
+	first := f.DocumentCategory().Build()
+	second := f.DocumentCategory().WithSlug("invoice").WithQuota(1024, 2).Build()
+
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.NotEqual(t, first.Name, second.Name)
+	assert.Equal(t, "invoice", second.Slug)
+	assert.Equal(t, int64(1024), second.QuotaBytes)
+	assert.Equal(t, int64(2), second.QuotaObjects)
+}
+
+func TestFactoryDocumentBuild(t *testing.T) {
+	f := factory.New(nil, memory.NewDriver())
+	category := f.DocumentCategory().WithSlug("invoice").Build()
+
+	document := f.Document().
+		WithCategory(category).
+		WithOriginalName("invoice.pdf").
+		With(func(document *entity.Document) { document.Token = "token" }).
+		Build()
+
+	assert.Equal(t, category.ID, document.CategoryID)
+	assert.Equal(t, "invoice.pdf", document.OriginalName)
+	assert.Equal(t, "application/pdf", document.Type)
+	assert.Equal(t, "invoice/"+document.ID+".pdf", document.Path)
+	assert.Equal(t, "token", document.Token)
+	assert.Greater(t, document.Size, int64(0))
+
+	other := f.Document().Build()
+	assert.NotEqual(t, document.ID, other.ID)
+	assert.NotEqual(t, document.CategoryID, other.CategoryID)
+}
+
+func TestFactoryDocumentCategoryCreate(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	created := f.DocumentCategory().WithSlug("invoice").WithQuota(1024, 2).MustCreate(t)
+
+	found, err := dbClient.DocumentCategory.FindDocumentCategory(ctx, &entity.DocumentCategory{ID: created.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "invoice", found.Slug)
+	assert.Equal(t, created.Name, found.Name)
+	assert.Equal(t, int64(1024), found.QuotaBytes)
+	assert.Equal(t, int64(2), found.QuotaObjects)
+
+	_, err = f.DocumentCategory().WithSlug("invoice").Create(ctx)
+	assert.Error(t, err)
+}
+
+func TestFactoryDocumentCreate(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	driver := memory.NewDriver()
+	f := factory.New(dbClient, driver)
+
+	content := []byte("%PDF-1.4\n%%EOF\n")
+	category := f.DocumentCategory().WithSlug("invoice").WithQuota(0, 1).MustCreate(t)
+	document := f.Document().WithCategory(category).WithContent(content).MustCreate(t)
+
+	found, err := dbClient.Document.FindDocument(ctx, &entity.Document{ID: document.ID})
+	require.NoError(t, err)
+	assert.Equal(t, category.ID, found.CategoryID)
+	assert.Equal(t, "invoice/"+document.ID+".pdf", found.Path)
+	assert.Equal(t, int64(len(content)), found.Size)
+
+	uploaded, err := driver.GetObject(found.Path)
+	require.NoError(t, err)
+	assert.Equal(t, content, uploaded)
+
+	usage, err := dbClient.StorageUsage.FindStorageUsage(ctx, &entity.StorageUsage{
+		SubjectType: entity.StorageSubjectDocumentCategory,
+		SubjectID:   category.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), usage.UsedObjects)
+	assert.Equal(t, int64(len(content)), usage.UsedBytes)
+
+	// The quota of the category is full, so the document is not saved and its uploaded object is deleted.
+	_, err = f.Document().
+		WithCategory(category).
+		With(func(document *entity.Document) { document.Path = "invoice/rejected.pdf" }).
+		Create(ctx)
+	assert.Error(t, err)
+	assert.False(t, driver.HasObject("invoice/rejected.pdf"))
+
+	// The category is created with the document when it is not given.
+	other := f.Document().MustCreate(t)
+	_, err = dbClient.DocumentCategory.FindDocumentCategory(ctx, &entity.DocumentCategory{ID: other.CategoryID})
+	assert.NoError(t, err)
+	assert.True(t, driver.HasObject(other.Path))
+
+	_, err = factory.New(dbClient, nil).Document().Create(ctx)
+	assert.Error(t, err)
+}
@@ -0,0 +1,165 @@
+package migrations
+
+import (
+	"micro/pkg/domain/migration"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// The initial schema is the schema the former auto migrate created, so the database created by it is brought under
+// the versioned migrations as is. The tables are frozen as the snapshot below, so the migration keeps creating the
+// same schema when the entities change, the later changes of the entities are added by their own migrations.
+func init() {
+	register(migration.Migration{
+		Version: "20230101000000",
+		Name:    "create_initial_schema",
+		Up: func(tx *gorm.DB) error {
+			err := fixInitialDocumentCategorySize(tx)
+			if err != nil {
+				return err
+			}
+
+			return tx.AutoMigrate(
+				&initialDocument{},
+				&initialDocumentCategory{},
+				&initialDocumentPurgeReport{},
+				&initialLegalHoldAudit{},
+				&initialStorageUsage{},
+			)
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(
+				"documents",
+				"document_categories",
+				"document_purge_reports",
+				"legal_hold_audits",
+				"storage_usages",
+			)
+		},
+	})
+}
+
+// fixInitialDocumentCategorySize will fix the fractional and negative sizes of the existing categories.
+// The size was stored as a floating point, so it is fixed first to migrate the column to an integer byte count safely.
+func fixInitialDocumentCategorySize(tx *gorm.DB) error {
+	if !tx.Migrator().HasTable(&initialDocumentCategory{}) {
+		return nil
+	}
+
+	err := tx.Model(&initialDocumentCategory{}).Where("size < 0").UpdateColumn("size", 0).Error
+	if err != nil {
+		return err
+	}
+
+	// SQLite has no CEIL unless it is built with the math functions, its table is never created with the
+	// floating point size, so there is no fractional size to fix.
+	if tx.Dialector.Name() == "sqlite" {
+		return nil
+	}
+
+	return tx.Model(&initialDocumentCategory{}).Where("size <> CEIL(size)").UpdateColumn("size", gorm.Expr("CEIL(size)")).Error
+}
+
+// initialDocument is the snapshot of the documents table of the initial schema.
+type initialDocument struct {
+	ID              string `gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	CategoryID      string `gorm:"size:36;not null;index;"`
+	OriginalName    string `gorm:"size:255;not null;"`
+	Name            string `gorm:"size:255;not null;"`
+	Path            string `gorm:"size:255;not null;"`
+	Type            string `gorm:"size:36;not null;"`
+	Size            int64  `gorm:"not null;"`
+	Token           string `gorm:"size:300;"`
+	LegalHold       bool   `gorm:"not null;default:false;index;"`
+	LegalHoldReason string `gorm:"size:255;"`
+	LegalHoldBy     string `gorm:"size:100;"`
+	LegalHoldAt     *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       gorm.DeletedAt
+}
+
+// TableName return name of table.
+func (initialDocument) TableName() string {
+	return "documents"
+}
+
+// initialDocumentCategory is the snapshot of the document_categories table of the initial schema.
+type initialDocumentCategory struct {
+	ID                    string `gorm:"size:36;not null;unique_index;primary_key"`
+	Slug                  string `gorm:"size:100;not null;index;"`
+	Name                  string `gorm:"size:100;not null;index;"`
+	Description           string `gorm:"size:255;not null;"`
+	MimeTypes             string `gorm:"size:255;not null;"`
+	Size                  int64  `gorm:"type:bigint;not null;"`
+	PurgeDeletedAfterDays int    `gorm:"not null;default:0;"`
+	ExpireActiveAfterDays int    `gorm:"not null;default:0;"`
+	QuotaBytes            int64  `gorm:"not null;default:0;"`
+	QuotaObjects          int64  `gorm:"not null;default:0;"`
+	LegalHold             bool   `gorm:"not null;default:false;index;"`
+	LegalHoldReason       string `gorm:"size:255;"`
+	LegalHoldBy           string `gorm:"size:100;"`
+	LegalHoldAt           *time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	DeletedAt             gorm.DeletedAt
+}
+
+// TableName return name of table.
+func (initialDocumentCategory) TableName() string {
+	return "document_categories"
+}
+
+// initialDocumentPurgeReport is the snapshot of the document_purge_reports table of the initial schema.
+type initialDocumentPurgeReport struct {
+	ID                string    `gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	CategoryID        string    `gorm:"size:36;not null;index;"`
+	Reason            string    `gorm:"size:36;not null;index;"`
+	Cutoff            time.Time `gorm:"not null;"`
+	TotalPurged       int64     `gorm:"not null;default:0;"`
+	TotalFailed       int64     `gorm:"not null;default:0;"`
+	PurgedBytes       int64     `gorm:"not null;default:0;"`
+	FailedDocumentIDs string    `gorm:"type:text;"`
+	StartedAt         time.Time `gorm:"not null;"`
+	FinishedAt        time.Time `gorm:"not null;"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// TableName return name of table.
+func (initialDocumentPurgeReport) TableName() string {
+	return "document_purge_reports"
+}
+
+// initialLegalHoldAudit is the snapshot of the legal_hold_audits table of the initial schema.
+type initialLegalHoldAudit struct {
+	ID          string `gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	SubjectType string `gorm:"size:36;not null;index:idx_legal_hold_audits_subject;"`
+	SubjectID   string `gorm:"size:36;not null;index:idx_legal_hold_audits_subject;"`
+	Action      string `gorm:"size:36;not null;"`
+	Reason      string `gorm:"size:255;not null;"`
+	Actor       string `gorm:"size:100;not null;"`
+	CreatedAt   time.Time
+}
+
+// TableName return name of table.
+func (initialLegalHoldAudit) TableName() string {
+	return "legal_hold_audits"
+}
+
+// initialStorageUsage is the snapshot of the storage_usages table of the initial schema.
+type initialStorageUsage struct {
+	ID          string `gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	SubjectType string `gorm:"size:36;not null;uniqueIndex:idx_storage_usages_subject;"`
+	SubjectID   string `gorm:"size:36;not null;uniqueIndex:idx_storage_usages_subject;"`
+	UsedBytes   int64  `gorm:"not null;default:0;"`
+	UsedObjects int64  `gorm:"not null;default:0;"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TableName return name of table.
+func (initialStorageUsage) TableName() string {
+	return "storage_usages"
+}
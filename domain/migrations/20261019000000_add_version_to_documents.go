@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"micro/pkg/domain/migration"
+
+	"gorm.io/gorm"
+)
+
+// The version of the document categories and the documents is used for the optimistic locking of their updates.
+func init() {
+	register(migration.Migration{
+		Version: "20261019000000",
+		Name:    "add_version_to_documents",
+		Up: func(tx *gorm.DB) error {
+			for _, model := range []interface{}{&versionDocumentCategory{}, &versionDocument{}} {
+				err := tx.Migrator().AddColumn(model, "Version")
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Down: func(tx *gorm.DB) error {
+			for _, model := range []interface{}{&versionDocumentCategory{}, &versionDocument{}} {
+				err := tx.Migrator().DropColumn(model, "Version")
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	})
+}
+
+// versionDocumentCategory is the snapshot of the version column of the document_categories table.
+type versionDocumentCategory struct {
+	Version int64 `gorm:"not null;default:1;"`
+}
+
+// TableName return name of table.
+func (versionDocumentCategory) TableName() string {
+	return "document_categories"
+}
+
+// versionDocument is the snapshot of the version column of the documents table.
+type versionDocument struct {
+	Version int64 `gorm:"not null;default:1;"`
+}
+
+// TableName return name of table.
+func (versionDocument) TableName() string {
+	return "documents"
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"micro/domain/entity"
+	"micro/pkg/domain/migration"
+
+	"gorm.io/gorm"
+)
+
+// The slug of the document categories which are not deleted is unique. The migration fails while there are
+// duplicated slugs, they must be renamed or deleted first.
+func init() {
+	register(migration.Migration{
+		Version: "20261019000100",
+		Name:    "add_unique_slug_to_document_categories",
+		Up: func(tx *gorm.DB) error {
+			return (&entity.DocumentCategory{}).AfterAutoMigrate(tx)
+		},
+		Down: func(tx *gorm.DB) error {
+			if !tx.Migrator().HasIndex(&entity.DocumentCategory{}, entity.DocumentCategorySlugIndex) {
+				return nil
+			}
+
+			return tx.Migrator().DropIndex(&entity.DocumentCategory{}, entity.DocumentCategorySlugIndex)
+		},
+	})
+}
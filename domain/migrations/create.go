@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"fmt"
+	"micro/pkg/domain/migration"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// reName match the name of the migration.
+var reName = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+const goTemplate = `package migrations
+
+import (
+	"micro/pkg/domain/migration"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	register(migration.Migration{
+		Version: "%s",
+		Name:    "%s",
+		Up: func(tx *gorm.DB) error {
+			return nil
+		},
+		Down: func(tx *gorm.DB) error {
+			return nil
+		},
+	})
+}
+`
+
+// CreateGo will create the Go migration file on the dir, the path of the created file is returned.
+func CreateGo(dir string, name string, now time.Time) (string, error) {
+	if !reName.MatchString(name) {
+		return "", fmt.Errorf("migration name %s: must be lowercase letters, digits or underscore", name)
+	}
+
+	version := now.UTC().Format(migration.VersionLayout)
+	path := filepath.Join(dir, fmt.Sprintf("%s_%s.go", version, name))
+
+	err := os.WriteFile(path, []byte(fmt.Sprintf(goTemplate, version, name)), 0644)
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
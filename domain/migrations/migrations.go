@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+	"micro/pkg/domain/migration"
+)
+
+// Dir is the directory of the Go migrations, the SQL migrations are on the sql directory under it.
+const Dir = "domain/migrations"
+
+//go:embed sql
+var sqlFiles embed.FS
+
+// goMigrations holds the Go migrations which are registered by the init function of each migration file.
+var goMigrations []migration.Migration
+
+// register will add the Go migration to the collection of migrations.
+func register(m migration.Migration) {
+	goMigrations = append(goMigrations, m)
+}
+
+// NewMigrations will return the Go migrations and the embedded SQL migrations.
+func NewMigrations() ([]migration.Migration, error) {
+	sqlDir, err := fs.Sub(sqlFiles, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	sqlMigrations, err := migration.LoadSQL(sqlDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]migration.Migration{}, goMigrations...), sqlMigrations...), nil
+}
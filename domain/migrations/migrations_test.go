@@ -0,0 +1,88 @@
+package migrations_test
+
+import (
+	"fmt"
+	"micro/domain/migrations"
+	"micro/domain/registry"
+	"micro/pkg/configurator"
+	"micro/pkg/domain/migration"
+	"micro/pkg/provider/connection"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// newTestDB return a new SQLite test database of the name.
+func newTestDB(t *testing.T, name string) *gorm.DB {
+	t.Helper()
+
+	config := &configurator.Config{TestMode: true, MaxIdleCons: 2, MaxOpenCons: 4}
+	config.DBTestConfig = configurator.DBTestConfig{DBDriver: "sqlite", DBName: filepath.Join(t.TempDir(), name)}
+
+	db, err := connection.NewDBConnection(config)
+	require.NoError(t, err)
+
+	return db
+}
+
+// schemaOf return the columns and the indexes of each table of the entities, sorted by the name.
+func schemaOf(t *testing.T, db *gorm.DB) map[string][]string {
+	t.Helper()
+
+	schema := make(map[string][]string)
+	for _, table := range registry.CollectTableNames() {
+		name := table.Name.(string)
+
+		columnTypes, err := db.Migrator().ColumnTypes(name)
+		require.NoError(t, err)
+		for _, columnType := range columnTypes {
+			nullable, _ := columnType.Nullable()
+			defaultValue, _ := columnType.DefaultValue()
+			schema[name] = append(schema[name], fmt.Sprintf("column %s %s null=%t default=%s",
+				columnType.Name(), strings.ToLower(columnType.DatabaseTypeName()), nullable, defaultValue))
+		}
+
+		indexes, err := db.Migrator().GetIndexes(name)
+		require.NoError(t, err)
+		for _, index := range indexes {
+			unique, _ := index.Unique()
+			schema[name] = append(schema[name], fmt.Sprintf("index %s %v unique=%t", index.Name(), index.Columns(), unique))
+		}
+
+		sort.Strings(schema[name])
+	}
+
+	return schema
+}
+
+func TestMigrationsMatchEntities(t *testing.T) {
+	db := newTestDB(t, "micro_test.db")
+
+	expected := newTestDB(t, "micro_expected.db")
+	require.NoError(t, registry.NewRegistry().AutoMigrate(expected))
+
+	collection, err := migrations.NewMigrations()
+	require.NoError(t, err)
+	migrator := migration.New(collection)
+
+	applied, err := migrator.Migrate(db)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrator.Migrations))
+	assert.NoError(t, migrator.CheckPending(db))
+
+	// The frozen initial schema and the later migrations build the schema of the entities.
+	assert.Equal(t, schemaOf(t, expected), schemaOf(t, db))
+
+	rolledBack, err := migrator.Rollback(db, len(migrator.Migrations))
+	require.NoError(t, err)
+	assert.Len(t, rolledBack, len(migrator.Migrations))
+
+	for _, table := range registry.CollectTableNames() {
+		assert.False(t, db.Migrator().HasTable(table.Name))
+	}
+}
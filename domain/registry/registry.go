@@ -10,6 +10,9 @@ func CollectEntities() []registry.Entity {
 	return []registry.Entity{
 		{Entity: entity.Document{}},
 		{Entity: entity.DocumentCategory{}},
+		{Entity: entity.DocumentPurgeReport{}},
+		{Entity: entity.LegalHoldAudit{}},
+		{Entity: entity.StorageUsage{}},
 	}
 }
 
@@ -17,9 +20,15 @@ func CollectEntities() []registry.Entity {
 func CollectTableNames() []registry.Table {
 	var Document entity.Document
 	var DocumentCategory entity.DocumentCategory
+	var DocumentPurgeReport entity.DocumentPurgeReport
+	var LegalHoldAudit entity.LegalHoldAudit
+	var StorageUsage entity.StorageUsage
 	return []registry.Table{
 		{Name: Document.TableName()},
 		{Name: DocumentCategory.TableName()},
+		{Name: DocumentPurgeReport.TableName()},
+		{Name: LegalHoldAudit.TableName()},
+		{Name: StorageUsage.TableName()},
 	}
 }
 
@@ -11,7 +11,11 @@ type DocumentCategoryRepositoryInterface interface {
 	DeleteDocumentCategory(context.Context, *entity.DocumentCategory) (*entity.DocumentCategory, error)
 	FindDocumentCategory(context.Context, *entity.DocumentCategory) (*entity.DocumentCategory, error)
 	FindDocumentCategoryBySlug(context.Context, *entity.DocumentCategory) (*entity.DocumentCategory, error)
+	GetDeletedDocumentCategories(context.Context, *parameter.SQLQueryParameters) (entity.DocumentCategories, *parameter.ResponseMetadata, error)
 	GetDocumentCategories(context.Context, *parameter.SQLQueryParameters) (entity.DocumentCategories, *parameter.ResponseMetadata, error)
+	GetDocumentCategoriesWithRetention(context.Context) (entity.DocumentCategories, error)
+	IsDocumentCategorySlugTaken(context.Context, *entity.DocumentCategory) (bool, error)
+	RestoreDocumentCategory(context.Context, *entity.DocumentCategory) (*entity.DocumentCategory, error)
 	SaveDocumentCategory(context.Context, *entity.DocumentCategory) (*entity.DocumentCategory, error)
 	UpdateDocumentCategory(context.Context, *entity.DocumentCategory) (*entity.DocumentCategory, error)
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"micro/domain/entity"
+	"micro/pkg/parameter"
+)
+
+// DocumentPurgeReportRepositoryInterface need to be implements in persistence repository.
+type DocumentPurgeReportRepositoryInterface interface {
+	GetDocumentPurgeReports(context.Context, *parameter.SQLQueryParameters) (entity.DocumentPurgeReports, *parameter.ResponseMetadata, error)
+	SaveDocumentPurgeReport(context.Context, *entity.DocumentPurgeReport) (*entity.DocumentPurgeReport, error)
+}
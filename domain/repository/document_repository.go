@@ -4,6 +4,7 @@ import (
 	"context"
 	"micro/domain/entity"
 	"micro/pkg/parameter"
+	"time"
 )
 
 // DocumentRepositoryInterface need to be implements in persistence repository.
@@ -13,7 +14,12 @@ type DocumentRepositoryInterface interface {
 	FindDocumentByIDAndCategoryID(context.Context, *entity.Document) (*entity.Document, error)
 	FindDocumentByPath(context.Context, *entity.Document) (*entity.Document, error)
 	FindDocumentByEntity(context.Context, *entity.Document) (*entity.Document, error)
+	GetDeletedDocuments(context.Context, *parameter.SQLQueryParameters) (entity.Documents, *parameter.ResponseMetadata, error)
 	GetDocuments(context.Context, *parameter.SQLQueryParameters) (entity.Documents, *parameter.ResponseMetadata, error)
+	GetExpiredDocuments(ctx context.Context, r *entity.Document, before time.Time, excludeIDs []string, limit int) (entity.Documents, error)
+	GetDeletedDocumentsBefore(ctx context.Context, r *entity.Document, before time.Time, excludeIDs []string, limit int) (entity.Documents, error)
+	PurgeDocument(context.Context, *entity.Document) error
+	RestoreDocument(context.Context, *entity.Document) (*entity.Document, error)
 	SaveDocument(context.Context, *entity.Document) (*entity.Document, error)
 	UpdateDocument(ctx context.Context, target *entity.Document, value *entity.Document) error
 }
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"fmt"
+	"micro/pkg/exception"
+)
+
+var (
+	// ErrLegalHoldActive is returned when an operation is refused because the subject is on legal hold.
+	ErrLegalHoldActive = exception.New(exception.KindFailedPrecondition, "error.legal_hold.active")
+
+	// ErrLegalHoldNotActive is returned when releasing a legal hold of a subject which is not on legal hold.
+	ErrLegalHoldNotActive = exception.New(exception.KindFailedPrecondition, "error.legal_hold.not_active")
+
+	// ErrLegalHoldSubjectNotFound is returned when the subject of a legal hold does not exist.
+	ErrLegalHoldSubjectNotFound = exception.New(exception.KindNotFound, "error.legal_hold.subject_not_found")
+
+	// ErrLegalHoldInvalidSubjectType is returned when the subject type of a legal hold is not supported.
+	ErrLegalHoldInvalidSubjectType = exception.New(exception.KindValidation, "error.legal_hold.invalid_subject_type")
+
+	// ErrQuotaExceeded is returned when an operation is refused because it would exceed a storage quota.
+	ErrQuotaExceeded = exception.New(exception.KindResourceExhausted, "error.storage_quota.exceeded")
+
+	// ErrConflict is returned when a row would have the same unique value as another row.
+	ErrConflict = exception.New(exception.KindConflict, "error.common.conflict")
+
+	// ErrVersionConflict is returned when an update is refused because the row was changed since the expected version.
+	ErrVersionConflict = exception.New(exception.KindVersionConflict, "error.common.version_conflict")
+)
+
+// QuotaViolation describe a single storage quota which would be exceeded.
+type QuotaViolation struct {
+	SubjectType string
+	SubjectID   string
+	Quota       string
+	Limit       int64
+	Used        int64
+	Requested   int64
+}
+
+// Subject return the subject of the violation, e.g. document_category:<id>.
+func (v QuotaViolation) Subject() string {
+	return fmt.Sprintf("%s:%s", v.SubjectType, v.SubjectID)
+}
+
+// Description return the human readable description of the violation.
+func (v QuotaViolation) Description() string {
+	return fmt.Sprintf("%s quota exceeded: used %d, requested %d, limit %d", v.Quota, v.Used, v.Requested, v.Limit)
+}
+
+// QuotaExceededError is returned with the violations when an operation would exceed a storage quota.
+// It matches ErrQuotaExceeded with errors.Is.
+type QuotaExceededError struct {
+	Violations []QuotaViolation
+}
+
+// Error return the error message.
+func (e *QuotaExceededError) Error() string {
+	return ErrQuotaExceeded.Error()
+}
+
+// Unwrap return ErrQuotaExceeded.
+func (e *QuotaExceededError) Unwrap() error {
+	return ErrQuotaExceeded
+}
+
+// ErrorDetails return the violations as the field details, the scope is the quota.
+func (e *QuotaExceededError) ErrorDetails() exception.ErrorRPCList {
+	var details exception.ErrorRPCList
+	for _, violation := range e.Violations {
+		details = append(details, exception.ErrorRPC{
+			Scope: violation.Quota,
+			Field: violation.Subject(),
+			Msg:   violation.Description(),
+			Data: map[string]interface{}{
+				"limit":     violation.Limit,
+				"used":      violation.Used,
+				"requested": violation.Requested,
+			},
+		})
+	}
+
+	return details
+}
+
+// ConflictError is returned with the field of the unique value when a row would have the same unique value as
+// another row. It matches ErrConflict with errors.Is.
+type ConflictError struct {
+	Field string
+}
+
+// Error return the error message.
+func (e *ConflictError) Error() string {
+	return ErrConflict.Error()
+}
+
+// Unwrap return ErrConflict.
+func (e *ConflictError) Unwrap() error {
+	return ErrConflict
+}
+
+// ErrorDetails return the field of the conflict as the field details.
+func (e *ConflictError) ErrorDetails() exception.ErrorRPCList {
+	return exception.ErrorRPCList{
+		{
+			Field: e.Field,
+			Msg:   "validation.error.already_exists",
+		},
+	}
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"micro/domain/entity"
+	"micro/pkg/parameter"
+)
+
+// LegalHoldRepositoryInterface need to be implements in persistence repository.
+type LegalHoldRepositoryInterface interface {
+	GetLegalHoldAudits(context.Context, *parameter.SQLQueryParameters) (entity.LegalHoldAudits, *parameter.ResponseMetadata, error)
+	PlaceLegalHold(context.Context, *entity.LegalHoldAudit) (*entity.LegalHoldAudit, error)
+	ReleaseLegalHold(context.Context, *entity.LegalHoldAudit) (*entity.LegalHoldAudit, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"micro/domain/entity"
+)
+
+// StorageUsageRepositoryInterface need to be implements in persistence repository.
+type StorageUsageRepositoryInterface interface {
+	FindStorageUsage(context.Context, *entity.StorageUsage) (*entity.StorageUsage, error)
+	CheckDocumentCategoryQuota(ctx context.Context, category *entity.DocumentCategory, bytes int64, objects int64) error
+	RecalculateStorageUsages(context.Context) error
+}
@@ -0,0 +1,163 @@
+package seeds
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"micro/domain/entity"
+	"micro/persistence"
+	"micro/pkg/filestore"
+	"micro/pkg/filestore/object"
+	"path"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Fixture is a struct uses to holds the document categories and the documents of a fixture file.
+type Fixture struct {
+	DocumentCategories []DocumentCategoryFixture `yaml:"document_categories" json:"document_categories"`
+	Documents          []DocumentFixture         `yaml:"documents" json:"documents"`
+}
+
+// DocumentCategoryFixture is the fixture of entity.DocumentCategory, the category is skipped when the slug exists.
+type DocumentCategoryFixture struct {
+	ID                    string `yaml:"id" json:"id"`
+	Slug                  string `yaml:"slug" json:"slug"`
+	Name                  string `yaml:"name" json:"name"`
+	Description           string `yaml:"description" json:"description"`
+	MimeTypes             string `yaml:"mime_types" json:"mime_types"`
+	Size                  int64  `yaml:"size" json:"size"`
+	PurgeDeletedAfterDays int    `yaml:"purge_deleted_after_days" json:"purge_deleted_after_days"`
+	ExpireActiveAfterDays int    `yaml:"expire_active_after_days" json:"expire_active_after_days"`
+	QuotaBytes            int64  `yaml:"quota_bytes" json:"quota_bytes"`
+	QuotaObjects          int64  `yaml:"quota_objects" json:"quota_objects"`
+}
+
+// DocumentFixture is the fixture of entity.Document, the file is uploaded to the file storage under the slug of
+// the category. The file path is relative to the directory of the environment.
+type DocumentFixture struct {
+	ID           string `yaml:"id" json:"id"`
+	Category     string `yaml:"category" json:"category"`
+	File         string `yaml:"file" json:"file"`
+	OriginalName string `yaml:"original_name" json:"original_name"`
+}
+
+// seeder return the function which create the document categories and then the documents of the fixture, and the
+// function which delete the objects uploaded by it, which is called when its transaction is rolled back.
+func (f Fixture) seeder(fsys fs.FS, fileStorage filestore.Interface) (func(tx *gorm.DB) error, func() error) {
+	var uploaded []string
+
+	run := func(tx *gorm.DB) error {
+		uploaded = nil
+		for _, category := range f.DocumentCategories {
+			err := createDocumentCategory(tx, category)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, document := range f.Documents {
+			objectPath, err := createDocument(tx, fsys, fileStorage, document)
+			if objectPath != "" {
+				uploaded = append(uploaded, objectPath)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	rollback := func() error {
+		var errDelete error
+		for _, objectPath := range uploaded {
+			err := fileStorage.DeleteObject(objectPath)
+			if err != nil && errDelete == nil {
+				errDelete = fmt.Errorf("delete uploaded object %s: %w", objectPath, err)
+			}
+		}
+		uploaded = nil
+
+		return errDelete
+	}
+
+	return run, rollback
+}
+
+// createDocumentCategory will create the document category when there is no category with the slug.
+func createDocumentCategory(tx *gorm.DB, fixture DocumentCategoryFixture) error {
+	var categoryExists entity.DocumentCategory
+	err := tx.Where("slug = ?", fixture.Slug).Take(&categoryExists).Error
+	if err == nil || !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	return tx.Create(&entity.DocumentCategory{
+		ID:                    fixture.ID,
+		Slug:                  fixture.Slug,
+		Name:                  fixture.Name,
+		Description:           fixture.Description,
+		MimeTypes:             fixture.MimeTypes,
+		Size:                  fixture.Size,
+		PurgeDeletedAfterDays: fixture.PurgeDeletedAfterDays,
+		ExpireActiveAfterDays: fixture.ExpireActiveAfterDays,
+		QuotaBytes:            fixture.QuotaBytes,
+		QuotaObjects:          fixture.QuotaObjects,
+	}).Error
+}
+
+// createDocument will upload the file of the document and save the document, so the storage usage is tracked.
+// The path of the uploaded object is returned even when the document can not be saved, so it is deleted on rollback.
+func createDocument(tx *gorm.DB, fsys fs.FS, fileStorage filestore.Interface, fixture DocumentFixture) (string, error) {
+	if fileStorage == nil {
+		return "", errors.New("file storage is required to seed the documents")
+	}
+
+	var category entity.DocumentCategory
+	err := tx.Where("slug = ?", fixture.Category).Take(&category).Error
+	if err != nil {
+		return "", fmt.Errorf("document category %s: %w", fixture.Category, err)
+	}
+
+	content, err := fs.ReadFile(fsys, fixture.File)
+	if err != nil {
+		return "", err
+	}
+
+	id := fixture.ID
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	originalName := fixture.OriginalName
+	if originalName == "" {
+		originalName = path.Base(fixture.File)
+	}
+
+	metadata := object.NewFromByteSlice(content, category.Slug,
+		object.WithID(id),
+		object.WithOriginalName(originalName),
+		object.WithPutMethod(object.DirectPut),
+		object.IncludeSlug(),
+		object.IncludeDate(),
+	)
+
+	_, err = fileStorage.PutObject(metadata)
+	if err != nil {
+		return "", fmt.Errorf("upload %s: %w", fixture.File, err)
+	}
+
+	_, err = persistence.NewDBService(tx).Document.SaveDocument(tx.Statement.Context, &entity.Document{
+		ID:           metadata.ID,
+		CategoryID:   category.ID,
+		OriginalName: metadata.OriginalName,
+		Name:         metadata.Filename(),
+		Path:         metadata.Filepath(),
+		Type:         metadata.ContentType,
+		Size:         metadata.Size,
+	})
+
+	return metadata.Filepath(), err
+}
@@ -0,0 +1,66 @@
+package seeds
+
+import (
+	"micro/domain/registry"
+	"micro/pkg/configurator"
+	"micro/pkg/domain/seed"
+	"micro/pkg/filestore/driver/memory"
+	"micro/pkg/filestore/object"
+	"micro/pkg/provider/connection"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDriver is a memory driver which records the path of the uploaded objects.
+type testDriver struct {
+	*memory.Driver
+	uploaded []string
+}
+
+func (d *testDriver) PutObject(m *object.Metadata) (*object.Metadata, error) {
+	d.uploaded = append(d.uploaded, m.Filepath())
+
+	return d.Driver.PutObject(m)
+}
+
+func TestFixtureSeederRollback(t *testing.T) {
+	config := &configurator.Config{TestMode: true, MaxIdleCons: 2, MaxOpenCons: 4}
+	config.DBTestConfig = configurator.DBTestConfig{DBDriver: "sqlite", DBName: filepath.Join(t.TempDir(), "micro_test.db")}
+
+	db, err := connection.NewDBConnection(config)
+	require.NoError(t, err)
+	require.NoError(t, registry.NewRegistry().AutoMigrate(db))
+
+	fsys := fstest.MapFS{"files/invoice.pdf": {Data: []byte("%PDF-1.4\n%%EOF\n")}}
+	driver := &testDriver{Driver: memory.NewDriver()}
+	fixture := Fixture{
+		DocumentCategories: []DocumentCategoryFixture{{Slug: "invoice", Name: "Invoice", MimeTypes: "application/pdf", Size: 1024}},
+		Documents: []DocumentFixture{
+			{Category: "invoice", File: "files/invoice.pdf"},
+			{Category: "invoice", File: "files/missing.pdf"},
+		},
+	}
+
+	run, rollback := fixture.seeder(fsys, driver)
+	_, err = seed.New([]seed.Seed{{Name: "test/documents", Run: run, Rollback: rollback}}).Seed(db)
+	assert.Error(t, err)
+
+	// The first document is uploaded before the second fails, it is deleted when the transaction is rolled back.
+	require.Len(t, driver.uploaded, 1)
+	assert.False(t, driver.HasObject(driver.uploaded[0]))
+
+	var categories int64
+	require.NoError(t, db.Table("document_categories").Count(&categories).Error)
+	assert.Zero(t, categories)
+
+	fixture.Documents = fixture.Documents[:1]
+	run, rollback = fixture.seeder(fsys, driver)
+	applied, err := seed.New([]seed.Seed{{Name: "test/documents", Run: run, Rollback: rollback}}).Seed(db)
+	require.NoError(t, err)
+	assert.Len(t, applied, 1)
+	assert.True(t, driver.HasObject(driver.uploaded[1]))
+}
@@ -0,0 +1,84 @@
+package seeds
+
+import (
+	"embed"
+	"io/fs"
+	"micro/pkg/domain/seed"
+	"micro/pkg/filestore"
+	"path"
+	"sort"
+	"strings"
+)
+
+// DefaultEnv is the fixture directory of the seeds which run on every environment.
+const DefaultEnv = "default"
+
+// fixtureFiles holds the fixtures, each directory is an environment, e.g. fixtures/dev/documents.json.
+// The files of the documents are read relative to the directory of the environment.
+//
+//go:embed fixtures
+var fixtureFiles embed.FS
+
+// NewSeeds will return a seed for each fixture file, named by the environment and the file name without the
+// extension, e.g. dev/documents. The seeds of the default directory run first, the files run in the order of the name.
+func NewSeeds(fileStorage filestore.Interface) ([]seed.Seed, error) {
+	fixturesDir, err := fs.Sub(fixtureFiles, "fixtures")
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := fs.ReadDir(fixturesDir, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var envs []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			envs = append(envs, entry.Name())
+		}
+	}
+
+	sort.SliceStable(envs, func(i, j int) bool {
+		return envs[i] == DefaultEnv && envs[j] != DefaultEnv
+	})
+
+	var seeds []seed.Seed
+	for _, env := range envs {
+		envDir, errSub := fs.Sub(fixturesDir, env)
+		if errSub != nil {
+			return nil, errSub
+		}
+
+		files, errRead := fs.ReadDir(envDir, ".")
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		for _, file := range files {
+			if file.IsDir() || !seed.IsFixture(file.Name()) {
+				continue
+			}
+
+			var fixture Fixture
+			errLoad := seed.LoadFixture(envDir, file.Name(), &fixture)
+			if errLoad != nil {
+				return nil, errLoad
+			}
+
+			run, rollback := fixture.seeder(envDir, fileStorage)
+			s := seed.Seed{
+				Name:     path.Join(env, strings.TrimSuffix(file.Name(), path.Ext(file.Name()))),
+				Run:      run,
+				Rollback: rollback,
+			}
+			if env != DefaultEnv {
+				s.Env = []string{env}
+			}
+
+			seeds = append(seeds, s)
+		}
+	}
+
+	return seeds, nil
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"micro/cmd"
 	"micro/docs"
+	"micro/domain/migrations"
 	"micro/domain/registry"
 	"micro/persistence"
 	"micro/pkg/provider/connection"
@@ -23,6 +24,7 @@ import (
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 
 	"micro/pkg/configurator"
+	"micro/pkg/domain/migration"
 	"micro/pkg/logger"
 	"micro/pkg/util"
 )
@@ -34,6 +36,8 @@ func main() {
 
 	config := configurator.New(
 		configurator.WithDBConfig(),
+		configurator.WithDBTestConfig(),
+		configurator.WithDBReplicasConfig(),
 		configurator.WithGoogleCloudServiceConfig(),
 		configurator.WithAmazonWebServiceConfig(),
 		configurator.WithMinioConfig(),
@@ -41,6 +45,7 @@ func main() {
 		configurator.WithS3Config(),
 		configurator.WithStorageConfig(),
 		configurator.WithDatadogConfig(),
+		configurator.WithSchedulerConfig(),
 	)
 
 	timeLoc, _ := time.LoadLocation(config.AppTimezone)
@@ -71,6 +76,12 @@ func main() {
 		logStd.Log.Fatalf("Unable to connect database: %v", errDBConn)
 	}
 
+	defer func() {
+		if err := connection.CloseDBConnection(dbConn); err != nil {
+			logStd.Log.Errorf("Unable to close database connection: %v", err)
+		}
+	}()
+
 	dbClient := persistence.NewDBService(dbConn)
 
 	fileStorageConn, errStorageConnection := connection.NewStorageConnection(config)
@@ -91,6 +102,12 @@ func main() {
 
 	entityRegistry := registry.NewRegistry()
 
+	migrationCollection, errMigrations := migrations.NewMigrations()
+	if errMigrations != nil {
+		logStd.Log.Fatalf("Unable to load database migrations: %v", errMigrations)
+	}
+	migrator := migration.New(migrationCollection)
+
 	// Swagger docs
 	docs.SwaggerInfo.Host = os.Getenv("APP_SWAGGER_HOST")
 	docs.SwaggerInfo.BasePath = ""
@@ -99,15 +116,16 @@ func main() {
 	app.Commands = cmd.NewCommand(
 		config,
 		entityRegistry,
+		migrator,
 		dbClient,
 		httpClient,
 		fileStorageClient,
 		logStd,
 	)
 	app.Action = func(c *cli.Context) error {
-		errAutoMigrate := entityRegistry.AutoMigrate(dbConn)
-		if errAutoMigrate != nil {
-			logStd.Log.Fatalf("Unable to run database migration: %v", errAutoMigrate)
+		errMigration := cmd.CheckMigration(config, migrator, dbConn, logStd)
+		if errMigration != nil {
+			logStd.Log.Fatalf("Unable to start with database migration: %v", errMigration)
 		}
 
 		httpRouter := router.
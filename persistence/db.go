@@ -7,16 +7,22 @@ import (
 
 // DBClient represent it self.
 type DBClient struct {
-	DB               *gorm.DB
-	Document         repository.DocumentRepositoryInterface
-	DocumentCategory repository.DocumentCategoryRepositoryInterface
+	DB                  *gorm.DB
+	Document            repository.DocumentRepositoryInterface
+	DocumentCategory    repository.DocumentCategoryRepositoryInterface
+	DocumentPurgeReport repository.DocumentPurgeReportRepositoryInterface
+	LegalHold           repository.LegalHoldRepositoryInterface
+	StorageUsage        repository.StorageUsageRepositoryInterface
 }
 
 // NewDBService will initialize db connection and return repositories.
 func NewDBService(db *gorm.DB) *DBClient {
 	return &DBClient{
-		DB:               db,
-		Document:         NewDocumentRepository(db),
-		DocumentCategory: NewDocumentCategoryRepository(db),
+		DB:                  db,
+		Document:            NewDocumentRepository(db),
+		DocumentCategory:    NewDocumentCategoryRepository(db),
+		DocumentPurgeReport: NewDocumentPurgeReportRepository(db),
+		LegalHold:           NewLegalHoldRepository(db),
+		StorageUsage:        NewStorageUsageRepository(db),
 	}
 }
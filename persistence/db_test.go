@@ -0,0 +1,33 @@
+package persistence_test
+
+import (
+	"micro/domain/registry"
+	"micro/persistence"
+	"micro/pkg/configurator"
+	"micro/pkg/provider/connection"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDBClient return DBClient of a new SQLite test database which is migrated from the entities.
+func newTestDBClient(t *testing.T) *persistence.DBClient {
+	t.Helper()
+
+	config := &configurator.Config{TestMode: true, MaxIdleCons: 2, MaxOpenCons: 4}
+	config.DBTestConfig = configurator.DBTestConfig{DBDriver: "sqlite", DBName: filepath.Join(t.TempDir(), "micro_test.db")}
+
+	db, err := connection.NewDBConnection(config)
+	require.NoError(t, err)
+	require.NoError(t, registry.NewRegistry().AutoMigrate(db))
+
+	t.Cleanup(func() {
+		sqlDB, err := db.DB()
+		if err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	return persistence.NewDBService(db)
+}
@@ -7,28 +7,50 @@ import (
 	"micro/pkg/parameter"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // DocumentCategoryRepo is a struct to store db connection.
 type DocumentCategoryRepo struct {
+	*Repository[entity.DocumentCategory, *entity.DocumentCategory]
 	db *gorm.DB
 }
 
 // NewDocumentCategoryRepository will initialize DocumentCategoryRepo repository.
 func NewDocumentCategoryRepository(db *gorm.DB) *DocumentCategoryRepo {
-	return &DocumentCategoryRepo{db}
+	return &DocumentCategoryRepo{NewRepository[entity.DocumentCategory](db), db}
 }
 
 // DocumentCategoryRepo implements the repository.DocumentCategoryRepositoryInterface.
 var _ repository.DocumentCategoryRepositoryInterface = &DocumentCategoryRepo{}
 
 // DeleteDocumentCategory will delete Document category from the database storage.
+// The category is locked while the legal holds are checked, the legal hold of its Documents locks it too, so a
+// concurrent legal hold is not missed.
 func (f *DocumentCategoryRepo) DeleteDocumentCategory(ctx context.Context, r *entity.DocumentCategory) (*entity.DocumentCategory, error) {
 	var dataEntity entity.DocumentCategory
 
-	err := f.db.WithContext(ctx).Where("id = ?", r.ID).Take(&dataEntity).Delete(&dataEntity).Error
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var totalDocumentsOnHold int64
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", r.ID).Take(&dataEntity).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(entity.Document{}).Where("category_id = ? AND legal_hold = ?", dataEntity.ID, true).Count(&totalDocumentsOnHold).Error
+		if err != nil {
+			return err
+		}
+
+		if dataEntity.LegalHold || totalDocumentsOnHold > 0 {
+			return repository.ErrLegalHoldActive
+		}
+
+		return tx.Delete(&dataEntity).Error
+	})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	return &dataEntity, nil
@@ -36,13 +58,7 @@ func (f *DocumentCategoryRepo) DeleteDocumentCategory(ctx context.Context, r *en
 
 // FindDocumentCategory will find Document category from the database storage.
 func (f *DocumentCategoryRepo) FindDocumentCategory(ctx context.Context, r *entity.DocumentCategory) (*entity.DocumentCategory, error) {
-	var dataEntity entity.DocumentCategory
-
-	err := f.db.WithContext(ctx).Where("id = ?", r.ID).Take(&dataEntity).Error
-	if err != nil {
-		return nil, err
-	}
-	return &dataEntity, nil
+	return f.Find(ctx, r.ID)
 }
 
 // FindDocumentCategoryBySlug will find Document category from the database storage.
@@ -51,31 +67,111 @@ func (f *DocumentCategoryRepo) FindDocumentCategoryBySlug(ctx context.Context, r
 
 	err := f.db.WithContext(ctx).Where("slug = ?", r.Slug).Take(&dataEntity).Error
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 	return &dataEntity, nil
 }
 
+// GetDeletedDocumentCategories will get soft-deleted Document categories from the database storage.
+func (f *DocumentCategoryRepo) GetDeletedDocumentCategories(ctx context.Context, q *parameter.SQLQueryParameters) (entity.DocumentCategories, *parameter.ResponseMetadata, error) {
+	dataEntities, meta, err := f.GetDeleted(ctx, q)
+	if err != nil {
+		return nil, nil, translateError(err)
+	}
+
+	return entity.DocumentCategories(dataEntities), meta, nil
+}
+
 // GetDocumentCategories will get Document categories from the database storage.
 func (f *DocumentCategoryRepo) GetDocumentCategories(ctx context.Context, q *parameter.SQLQueryParameters) (entity.DocumentCategories, *parameter.ResponseMetadata, error) {
-	var total int64
+	dataEntities, meta, err := f.Get(ctx, q)
+	if err != nil {
+		return nil, nil, translateError(err)
+	}
+
+	return entity.DocumentCategories(dataEntities), meta, nil
+}
+
+// GetDocumentCategoriesWithRetention will get Document categories which have at least one retention policy enabled.
+func (f *DocumentCategoryRepo) GetDocumentCategoriesWithRetention(ctx context.Context) (entity.DocumentCategories, error) {
 	var dataEntities entity.DocumentCategories
 
-	errTotal := f.db.WithContext(ctx).Model(entity.DocumentCategory{}).Where(q.QueryKey, q.QueryValue...).Where(q.DateRange).Count(&total).Limit(q.Limit).Offset(q.Offset).Find(&dataEntities).Error
-	if errTotal != nil {
-		return nil, nil, errTotal
+	err := f.db.WithContext(ctx).Where("(purge_deleted_after_days > 0 OR expire_active_after_days > 0) AND legal_hold = ?", false).Find(&dataEntities).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return dataEntities, nil
+}
+
+// IsDocumentCategorySlugTaken will check whether the slug is used by another Document category which is not deleted.
+func (f *DocumentCategoryRepo) IsDocumentCategorySlugTaken(ctx context.Context, r *entity.DocumentCategory) (bool, error) {
+	taken, err := isSlugTaken(f.db.WithContext(ctx), r)
+	if err != nil {
+		return false, translateError(err)
+	}
+
+	return taken, nil
+}
+
+// isSlugTaken check whether the slug is used by another Document category which is not deleted.
+func isSlugTaken(tx *gorm.DB, r *entity.DocumentCategory) (bool, error) {
+	var total int64
+
+	err := tx.Model(&entity.DocumentCategory{}).Where("slug = ? AND id <> ?", r.Slug, r.ID).Count(&total).Error
+	if err != nil {
+		return false, err
+	}
+
+	return total > 0, nil
+}
+
+// checkSlug return repository.ConflictError of the slug when it is used by another Document category, so the taken
+// slug is refused the same as the slug which is taken concurrently and refused by the unique index.
+func checkSlug(tx *gorm.DB, r *entity.DocumentCategory) error {
+	if r.Slug == "" {
+		return nil
+	}
+
+	taken, err := isSlugTaken(tx, r)
+	if err != nil {
+		return err
 	}
 
-	errList := f.db.WithContext(ctx).Where(q.QueryKey, q.QueryValue...).Where(q.DateRange).Order(q.Order).Limit(q.Limit).Offset(q.Offset).Find(&dataEntities).Error
-	if errList != nil {
-		return nil, nil, errList
+	if taken {
+		return &repository.ConflictError{Field: "slug"}
 	}
-	meta := parameter.NewMeta(q, total)
 
-	return dataEntities, meta, nil
+	return nil
+}
+
+// RestoreDocumentCategory will restore soft-deleted Document category in the database storage.
+// repository.ConflictError is returned when the slug was taken since by another Document category.
+func (f *DocumentCategoryRepo) RestoreDocumentCategory(ctx context.Context, r *entity.DocumentCategory) (*entity.DocumentCategory, error) {
+	var dataEntity entity.DocumentCategory
+
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", r.ID).Take(&dataEntity).Error
+		if err != nil {
+			return err
+		}
+
+		err = checkSlug(tx, &dataEntity)
+		if err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Model(&dataEntity).Update("deleted_at", nil).Error
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &dataEntity, nil
 }
 
 // SaveDocumentCategory will save Document category into the database storage.
+// repository.ConflictError is returned when the slug is taken by another Document category.
 func (f *DocumentCategoryRepo) SaveDocumentCategory(ctx context.Context, r *entity.DocumentCategory) (*entity.DocumentCategory, error) {
 	var dataEntity entity.DocumentCategory
 	dataEntity.ID = r.ID
@@ -84,27 +180,80 @@ func (f *DocumentCategoryRepo) SaveDocumentCategory(ctx context.Context, r *enti
 	dataEntity.Size = r.Size
 	dataEntity.MimeTypes = r.MimeTypes
 	dataEntity.Description = r.Description
+	dataEntity.PurgeDeletedAfterDays = r.PurgeDeletedAfterDays
+	dataEntity.ExpireActiveAfterDays = r.ExpireActiveAfterDays
+	dataEntity.QuotaBytes = r.QuotaBytes
+	dataEntity.QuotaObjects = r.QuotaObjects
+
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := checkSlug(tx, &dataEntity)
+		if err != nil {
+			return err
+		}
 
-	err := f.db.WithContext(ctx).Create(&dataEntity).Error
+		return tx.Create(&dataEntity).Error
+	})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	return &dataEntity, nil
 }
 
 // UpdateDocumentCategory will update Document category in the database storage.
+// The version of r is the expected version of the category, repository.ErrVersionConflict is returned when the
+// category was changed since, or by a concurrent update. The zero version updates the current version.
+// repository.ConflictError is returned when the slug is taken by another Document category.
+// The empty name and slug are kept, the other fields are replaced including their zero values.
+// The category is read, updated and read again in a single transaction, so it is never read from a lagging replica.
 func (f *DocumentCategoryRepo) UpdateDocumentCategory(ctx context.Context, r *entity.DocumentCategory) (*entity.DocumentCategory, error) {
 	var dataEntity entity.DocumentCategory
-	dataEntity.Name = r.Name
-	dataEntity.Slug = r.Slug
-	dataEntity.Size = r.Size
-	dataEntity.MimeTypes = r.MimeTypes
-	dataEntity.Description = r.Description
 
-	err := f.db.WithContext(ctx).Where("id = ?", r.ID).Updates(dataEntity).First(&dataEntity).Error
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current entity.DocumentCategory
+
+		err := tx.Where("id = ?", r.ID).Take(&current).Error
+		if err != nil {
+			return err
+		}
+
+		err = checkVersion(current.Version, r.Version)
+		if err != nil {
+			return err
+		}
+
+		err = checkSlug(tx, &entity.DocumentCategory{ID: current.ID, Slug: r.Slug})
+		if err != nil {
+			return err
+		}
+
+		// The zero values are updated too, so the retention policies and the quotas can be disabled again.
+		values := map[string]interface{}{
+			"size":                     r.Size,
+			"mime_types":               r.MimeTypes,
+			"description":              r.Description,
+			"purge_deleted_after_days": r.PurgeDeletedAfterDays,
+			"expire_active_after_days": r.ExpireActiveAfterDays,
+			"quota_bytes":              r.QuotaBytes,
+			"quota_objects":            r.QuotaObjects,
+			"version":                  current.Version + 1,
+		}
+		if r.Name != "" {
+			values["name"] = r.Name
+		}
+		if r.Slug != "" {
+			values["slug"] = r.Slug
+		}
+
+		err = updateVersion(tx, &entity.DocumentCategory{}, current.ID, current.Version, values)
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", current.ID).Take(&dataEntity).Error
+	})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	return &dataEntity, nil
@@ -1 +1,159 @@
 package persistence_test
+
+import (
+	"context"
+	"errors"
+	"micro/domain/entity"
+	"micro/domain/factory"
+	"micro/domain/repository"
+	"micro/pkg/filestore/driver/memory"
+	commonv1 "micro/transport/grpc/common/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentCategoryRepositoryTrashAndRestore(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+	fields := &entity.DocumentCategory{}
+
+	category := f.DocumentCategory().WithSlug("invoice").MustCreate(t)
+	f.DocumentCategory().WithSlug("receipt").MustCreate(t)
+
+	_, err := dbClient.DocumentCategory.DeleteDocumentCategory(ctx, category)
+	require.NoError(t, err)
+
+	categories, meta, err := dbClient.DocumentCategory.GetDocumentCategories(ctx, newTestQuery(t, &commonv1.Query{}, fields))
+	require.NoError(t, err)
+	require.Len(t, categories, 1)
+	assert.Equal(t, "receipt", categories[0].Slug)
+	assert.Equal(t, int64(1), meta.GetTotal())
+
+	categories, _, err = dbClient.DocumentCategory.GetDeletedDocumentCategories(ctx, newTestQuery(t, &commonv1.Query{Trashed: true}, fields))
+	require.NoError(t, err)
+	require.Len(t, categories, 1)
+	assert.Equal(t, category.ID, categories[0].ID)
+	assert.True(t, categories[0].DeletedAt.Valid)
+
+	// The slug of the trashed category can be taken, then the trashed category cannot be restored.
+	taken, err := dbClient.DocumentCategory.IsDocumentCategorySlugTaken(ctx, &entity.DocumentCategory{Slug: "invoice"})
+	require.NoError(t, err)
+	assert.False(t, taken)
+
+	other := f.DocumentCategory().WithSlug("invoice").MustCreate(t)
+
+	_, err = dbClient.DocumentCategory.RestoreDocumentCategory(ctx, category)
+	var errConflict *repository.ConflictError
+	require.True(t, errors.As(err, &errConflict))
+	assert.Equal(t, "slug", errConflict.Field)
+
+	_, err = dbClient.DocumentCategory.DeleteDocumentCategory(ctx, other)
+	require.NoError(t, err)
+
+	restored, err := dbClient.DocumentCategory.RestoreDocumentCategory(ctx, category)
+	require.NoError(t, err)
+	assert.False(t, restored.DeletedAt.Valid)
+
+	_, err = dbClient.DocumentCategory.SaveDocumentCategory(ctx, f.DocumentCategory().WithSlug("invoice").Build())
+	assert.True(t, errors.Is(err, repository.ErrConflict))
+}
+
+func TestDocumentCategoryRepositoryUpdateDocumentCategoryVersion(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	category := f.DocumentCategory().WithSlug("invoice").MustCreate(t)
+	assert.Equal(t, int64(1), category.Version)
+
+	updated, err := dbClient.DocumentCategory.UpdateDocumentCategory(ctx, &entity.DocumentCategory{ID: category.ID, Name: "Invoice", Version: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "Invoice", updated.Name)
+	assert.Equal(t, "invoice", updated.Slug)
+	assert.Equal(t, int64(2), updated.Version)
+
+	_, err = dbClient.DocumentCategory.UpdateDocumentCategory(ctx, &entity.DocumentCategory{ID: category.ID, Name: "Stale", Version: 1})
+	assert.True(t, errors.Is(err, repository.ErrVersionConflict))
+
+	updated, err = dbClient.DocumentCategory.UpdateDocumentCategory(ctx, &entity.DocumentCategory{ID: category.ID, Name: "Receipt"})
+	require.NoError(t, err)
+	assert.Equal(t, "Receipt", updated.Name)
+	assert.Equal(t, int64(3), updated.Version)
+}
+
+func TestDocumentCategoryRepositorySlugTaken(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	f.DocumentCategory().WithSlug("invoice").MustCreate(t)
+	receipt := f.DocumentCategory().WithSlug("receipt").MustCreate(t)
+
+	_, err := dbClient.DocumentCategory.SaveDocumentCategory(ctx, f.DocumentCategory().WithSlug("invoice").Build())
+	var errConflict *repository.ConflictError
+	require.True(t, errors.As(err, &errConflict))
+	assert.Equal(t, "slug", errConflict.Field)
+
+	_, err = dbClient.DocumentCategory.UpdateDocumentCategory(ctx, &entity.DocumentCategory{ID: receipt.ID, Slug: "invoice"})
+	errConflict = nil
+	require.True(t, errors.As(err, &errConflict))
+	assert.Equal(t, "slug", errConflict.Field)
+
+	// The category keeps its own slug.
+	updated, err := dbClient.DocumentCategory.UpdateDocumentCategory(ctx, &entity.DocumentCategory{ID: receipt.ID, Slug: "receipt", Name: "Receipt"})
+	require.NoError(t, err)
+	assert.Equal(t, "Receipt", updated.Name)
+}
+
+func TestDocumentCategoryRepositoryUpdateDocumentCategoryZeroValues(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	category := f.DocumentCategory().WithSlug("invoice").WithRetention(30, 365).WithQuota(1024, 10).MustCreate(t)
+	require.True(t, category.HasRetentionPolicy())
+
+	updated, err := dbClient.DocumentCategory.UpdateDocumentCategory(ctx, &entity.DocumentCategory{ID: category.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "invoice", updated.Slug)
+	assert.Equal(t, category.Name, updated.Name)
+	assert.Zero(t, updated.PurgeDeletedAfterDays)
+	assert.Zero(t, updated.ExpireActiveAfterDays)
+	assert.Zero(t, updated.QuotaBytes)
+	assert.Zero(t, updated.QuotaObjects)
+	assert.Zero(t, updated.Size)
+	assert.Empty(t, updated.MimeTypes)
+	assert.Empty(t, updated.Description)
+	assert.Equal(t, category.Version+1, updated.Version)
+
+	categories, err := dbClient.DocumentCategory.GetDocumentCategoriesWithRetention(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, categories)
+}
+
+func TestDocumentCategoryRepositoryRestoreDocumentCategorySlugTaken(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	category := f.DocumentCategory().WithSlug("invoice").MustCreate(t)
+	_, err := dbClient.DocumentCategory.DeleteDocumentCategory(ctx, category)
+	require.NoError(t, err)
+
+	f.DocumentCategory().WithSlug("invoice").MustCreate(t)
+
+	// The slug is checked before the category is restored, not only by the unique index.
+	require.NoError(t, dbClient.DB.Migrator().DropIndex(&entity.DocumentCategory{}, entity.DocumentCategorySlugIndex))
+
+	_, err = dbClient.DocumentCategory.RestoreDocumentCategory(ctx, category)
+	var errConflict *repository.ConflictError
+	require.True(t, errors.As(err, &errConflict))
+	assert.Equal(t, "slug", errConflict.Field)
+
+	var total int64
+	require.NoError(t, dbClient.DB.Model(&entity.DocumentCategory{}).Where("slug = ?", "invoice").Count(&total).Error)
+	assert.Equal(t, int64(1), total)
+}
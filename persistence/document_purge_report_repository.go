@@ -0,0 +1,45 @@
+package persistence
+
+import (
+	"context"
+	"micro/domain/entity"
+	"micro/domain/repository"
+	"micro/pkg/parameter"
+
+	"gorm.io/gorm"
+)
+
+// DocumentPurgeReportRepo is a struct to store db connection.
+type DocumentPurgeReportRepo struct {
+	db *gorm.DB
+}
+
+// NewDocumentPurgeReportRepository will initialize DocumentPurgeReportRepo repository.
+func NewDocumentPurgeReportRepository(db *gorm.DB) *DocumentPurgeReportRepo {
+	return &DocumentPurgeReportRepo{db}
+}
+
+// DocumentPurgeReportRepo implements the repository.DocumentPurgeReportRepositoryInterface.
+var _ repository.DocumentPurgeReportRepositoryInterface = &DocumentPurgeReportRepo{}
+
+// GetDocumentPurgeReports will get Document purge reports from the database storage.
+func (f *DocumentPurgeReportRepo) GetDocumentPurgeReports(ctx context.Context, q *parameter.SQLQueryParameters) (entity.DocumentPurgeReports, *parameter.ResponseMetadata, error) {
+	var dataEntities entity.DocumentPurgeReports
+
+	meta, err := paginate(f.db.WithContext(ctx), q, &dataEntities)
+	if err != nil {
+		return nil, nil, translateError(err)
+	}
+
+	return dataEntities, meta, nil
+}
+
+// SaveDocumentPurgeReport will save Document purge report into the database storage.
+func (f *DocumentPurgeReportRepo) SaveDocumentPurgeReport(ctx context.Context, r *entity.DocumentPurgeReport) (*entity.DocumentPurgeReport, error) {
+	err := f.db.WithContext(ctx).Create(r).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return r, nil
+}
@@ -5,30 +5,50 @@ import (
 	"micro/domain/entity"
 	"micro/domain/repository"
 	"micro/pkg/parameter"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // DocumentRepo is a struct to store db connection.
 type DocumentRepo struct {
+	*Repository[entity.Document, *entity.Document]
 	db *gorm.DB
 }
 
 // NewDocumentRepository will initialize DocumentRepo repository.
 func NewDocumentRepository(db *gorm.DB) *DocumentRepo {
-	return &DocumentRepo{db}
+	return &DocumentRepo{NewRepository[entity.Document](db), db}
 }
 
 // DocumentRepo implements the repository.DocumentRepositoryInterface.
 var _ repository.DocumentRepositoryInterface = &DocumentRepo{}
 
 // DeleteDocument will delete Document from the database storage.
+// The Document and its category are locked while the legal hold is checked, so a concurrent legal hold is not missed.
 func (f *DocumentRepo) DeleteDocument(ctx context.Context, r *entity.Document) (*entity.Document, error) {
 	var dataEntity entity.Document
 
-	err := f.db.WithContext(ctx).Where("id = ?", r.ID).Take(&dataEntity).Delete(&dataEntity).Error
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", r.ID).Take(&dataEntity).Error
+		if err != nil {
+			return err
+		}
+
+		onHold, err := isDocumentOnLegalHold(tx, &dataEntity)
+		if err != nil {
+			return err
+		}
+
+		if onHold {
+			return repository.ErrLegalHoldActive
+		}
+
+		return tx.Delete(&dataEntity).Error
+	})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	return &dataEntity, nil
@@ -36,13 +56,7 @@ func (f *DocumentRepo) DeleteDocument(ctx context.Context, r *entity.Document) (
 
 // FindDocument will find Document from the database storage.
 func (f *DocumentRepo) FindDocument(ctx context.Context, r *entity.Document) (*entity.Document, error) {
-	var dataEntity entity.Document
-
-	err := f.db.WithContext(ctx).Where("id = ?", r.ID).Take(&dataEntity).Error
-	if err != nil {
-		return nil, err
-	}
-	return &dataEntity, nil
+	return f.Find(ctx, r.ID)
 }
 
 // FindDocumentByIDAndCategoryID will find Document from the database storage.
@@ -51,7 +65,7 @@ func (f *DocumentRepo) FindDocumentByIDAndCategoryID(ctx context.Context, r *ent
 
 	err := f.db.WithContext(ctx).Where("id = ? AND category_id = ?", r.ID, r.CategoryID).Take(&dataEntity).Error
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 	return &dataEntity, nil
 }
@@ -62,7 +76,7 @@ func (f *DocumentRepo) FindDocumentByPath(ctx context.Context, r *entity.Documen
 
 	err := f.db.WithContext(ctx).Where("path = ?", r.Path).Take(&dataEntity).Error
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 	return &dataEntity, nil
 }
@@ -72,29 +86,119 @@ func (f *DocumentRepo) FindDocumentByEntity(ctx context.Context, document *entit
 	var dataEntity entity.Document
 
 	err := f.db.WithContext(ctx).Take(&dataEntity, document).Error
-	return &dataEntity, err
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &dataEntity, nil
+}
+
+// GetDeletedDocuments will get soft-deleted Documents from the database storage.
+func (f *DocumentRepo) GetDeletedDocuments(ctx context.Context, q *parameter.SQLQueryParameters) (entity.Documents, *parameter.ResponseMetadata, error) {
+	dataEntities, meta, err := f.GetDeleted(ctx, q)
+	if err != nil {
+		return nil, nil, translateError(err)
+	}
+
+	return entity.Documents(dataEntities), meta, nil
 }
 
 // GetDocuments will get Documents from the database storage.
 func (f *DocumentRepo) GetDocuments(ctx context.Context, q *parameter.SQLQueryParameters) (entity.Documents, *parameter.ResponseMetadata, error) {
-	var total int64
+	dataEntities, meta, err := f.Get(ctx, q)
+	if err != nil {
+		return nil, nil, translateError(err)
+	}
+
+	return entity.Documents(dataEntities), meta, nil
+}
+
+// GetExpiredDocuments will get active Documents of the category created before the given time, except the excluded ones.
+func (f *DocumentRepo) GetExpiredDocuments(ctx context.Context, r *entity.Document, before time.Time, excludeIDs []string, limit int) (entity.Documents, error) {
 	var dataEntities entity.Documents
 
-	errTotal := f.db.WithContext(ctx).Model(entity.Document{}).Where(q.QueryKey, q.QueryValue...).Where(q.DateRange).Count(&total).Limit(q.Limit).Offset(q.Offset).Find(&dataEntities).Error
-	if errTotal != nil {
-		return nil, nil, errTotal
+	err := f.db.WithContext(ctx).Scopes(excludeDocumentIDs(excludeIDs)).Where("category_id = ? AND legal_hold = ? AND created_at < ?", r.CategoryID, false, before).Order("created_at asc").Limit(limit).Find(&dataEntities).Error
+	if err != nil {
+		return nil, translateError(err)
 	}
 
-	errList := f.db.WithContext(ctx).Where(q.QueryKey, q.QueryValue...).Where(q.DateRange).Order(q.Order).Limit(q.Limit).Offset(q.Offset).Find(&dataEntities).Error
-	if errList != nil {
-		return nil, nil, errList
+	return dataEntities, nil
+}
+
+// GetDeletedDocumentsBefore will get soft-deleted Documents of the category deleted before the given time, except the
+// excluded ones.
+func (f *DocumentRepo) GetDeletedDocumentsBefore(ctx context.Context, r *entity.Document, before time.Time, excludeIDs []string, limit int) (entity.Documents, error) {
+	var dataEntities entity.Documents
+
+	err := f.db.WithContext(ctx).Unscoped().Scopes(excludeDocumentIDs(excludeIDs)).Where("category_id = ? AND legal_hold = ? AND deleted_at IS NOT NULL AND deleted_at < ?", r.CategoryID, false, before).Order("deleted_at asc").Limit(limit).Find(&dataEntities).Error
+	if err != nil {
+		return nil, translateError(err)
 	}
-	meta := parameter.NewMeta(q, total)
 
-	return dataEntities, meta, nil
+	return dataEntities, nil
+}
+
+// excludeDocumentIDs return the scope which excludes the documents, e.g. the ones which failed to be purged.
+func excludeDocumentIDs(ids []string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(ids) == 0 {
+			return db
+		}
+
+		return db.Where("id NOT IN ?", ids)
+	}
+}
+
+// PurgeDocument will permanently delete Document from the database storage, including soft-deleted one.
+// The Document and its category are locked while the legal hold is checked, so a concurrent legal hold is not missed.
+// The locks are held until the outer transaction ends, so the object is deleted in it after the Document is purged.
+func (f *DocumentRepo) PurgeDocument(ctx context.Context, r *entity.Document) error {
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dataEntity entity.Document
+
+		err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", r.ID).Take(&dataEntity).Error
+		if err != nil {
+			return err
+		}
+
+		onHold, err := isDocumentOnLegalHold(tx, &dataEntity)
+		if err != nil {
+			return err
+		}
+
+		if onHold {
+			return repository.ErrLegalHoldActive
+		}
+
+		err = tx.Unscoped().Where("id = ?", r.ID).Delete(&entity.Document{}).Error
+		if err != nil {
+			return err
+		}
+
+		return adjustStorageUsage(tx, entity.StorageSubjectDocumentCategory, dataEntity.CategoryID, -dataEntity.Size, -1)
+	})
+
+	return translateError(err)
+}
+
+// RestoreDocument will restore soft-deleted Document in the database storage.
+func (f *DocumentRepo) RestoreDocument(ctx context.Context, r *entity.Document) (*entity.Document, error) {
+	var dataEntity entity.Document
+
+	err := f.db.WithContext(ctx).Unscoped().Where("id = ? AND deleted_at IS NOT NULL", r.ID).Take(&dataEntity).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	err = f.db.WithContext(ctx).Unscoped().Model(&dataEntity).Update("deleted_at", nil).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &dataEntity, nil
 }
 
 // SaveDocument will save Document from the database storage.
+// The storage usage of the category is tracked and its quotas are enforced in the same transaction.
 func (f *DocumentRepo) SaveDocument(ctx context.Context, r *entity.Document) (*entity.Document, error) {
 	var dataEntity entity.Document
 	dataEntity.ID = r.ID
@@ -106,20 +210,124 @@ func (f *DocumentRepo) SaveDocument(ctx context.Context, r *entity.Document) (*e
 	dataEntity.Size = r.Size
 	dataEntity.Token = r.Token
 
-	err := f.db.WithContext(ctx).Create(&dataEntity).Error
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The Documents of the path are locked, so a legal hold placed on them concurrently is not overwritten.
+		var documents []struct {
+			LegalHold bool
+		}
+
+		err := tx.Model(entity.Document{}).Clauses(clause.Locking{Strength: "UPDATE"}).Select("legal_hold").Where("path = ?", r.Path).Find(&documents).Error
+		if err != nil {
+			return err
+		}
+
+		for _, document := range documents {
+			if document.LegalHold {
+				return repository.ErrLegalHoldActive
+			}
+		}
+
+		err = reserveDocumentCategoryStorage(tx, dataEntity.CategoryID, dataEntity.Size, 1)
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&dataEntity).Error
+	})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	return &dataEntity, nil
 }
 
 // UpdateDocument is to update a single row of data.
+// The version of the target is the expected version of the Document, repository.ErrVersionConflict is returned
+// when the Document was changed since, or by a concurrent update. The zero version updates the current version.
+// The Document is read, updated and read again in a single transaction, so it is never read from a lagging replica.
 func (f *DocumentRepo) UpdateDocument(ctx context.Context, target *entity.Document, value *entity.Document) error {
-	value.ID = ""
-	err := f.db.WithContext(ctx).Where(target).Updates(value).Find(&target, target).Error
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dataEntity entity.Document
+
+		conditions := *target
+		conditions.Version = 0
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&conditions).Take(&dataEntity).Error
+		if err != nil {
+			return err
+		}
+
+		err = checkVersion(dataEntity.Version, target.Version)
+		if err != nil {
+			return err
+		}
+
+		onHold, err := isDocumentOnLegalHold(tx, &dataEntity)
+		if err != nil {
+			return err
+		}
+
+		if onHold {
+			return repository.ErrLegalHoldActive
+		}
+
+		value.ID = ""
+		value.Version = dataEntity.Version + 1
+
+		err = moveDocumentStorageUsage(tx, &dataEntity, value)
+		if err != nil {
+			return err
+		}
+
+		err = updateVersion(tx, &entity.Document{}, dataEntity.ID, dataEntity.Version, value)
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", dataEntity.ID).Take(target).Error
+	})
 	if err != nil {
-		return err
+		return translateError(err)
 	}
+
 	return nil
 }
+
+// moveDocumentStorageUsage update the storage usage when the size or the category of the Document is changed.
+func moveDocumentStorageUsage(tx *gorm.DB, current *entity.Document, value *entity.Document) error {
+	categoryID := current.CategoryID
+	if value.CategoryID != "" {
+		categoryID = value.CategoryID
+	}
+
+	size := current.Size
+	if value.Size != 0 {
+		size = value.Size
+	}
+
+	if categoryID == current.CategoryID {
+		if size == current.Size {
+			return nil
+		}
+
+		return reserveDocumentCategoryStorage(tx, categoryID, size-current.Size, 0)
+	}
+
+	err := adjustStorageUsage(tx, entity.StorageSubjectDocumentCategory, current.CategoryID, -current.Size, -1)
+	if err != nil {
+		return err
+	}
+
+	return reserveDocumentCategoryStorage(tx, categoryID, size, 1)
+}
+
+// isDocumentOnLegalHold check whether the Document or its category is on legal hold.
+// The category is locked, so its legal hold cannot be placed until the transaction of tx ends.
+func isDocumentOnLegalHold(tx *gorm.DB, r *entity.Document) (bool, error) {
+	categoryOnHold, err := lockDocumentCategory(tx, r.CategoryID)
+	if err != nil {
+		return false, err
+	}
+
+	return r.LegalHold || categoryOnHold, nil
+}
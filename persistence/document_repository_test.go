@@ -1 +1,100 @@
 package persistence_test
+
+import (
+	"context"
+	"errors"
+	"micro/domain/entity"
+	"micro/domain/factory"
+	"micro/domain/repository"
+	"micro/pkg/exception"
+	"micro/pkg/filestore/driver/memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentRepositoryGetDocumentsToPurgeExcludeIDs(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	category := f.DocumentCategory().MustCreate(t)
+	first := f.Document().WithCategory(category).MustCreate(t)
+	second := f.Document().WithCategory(category).MustCreate(t)
+	before := time.Now().Add(time.Minute)
+
+	documents, err := dbClient.Document.GetExpiredDocuments(ctx, &entity.Document{CategoryID: category.ID}, before, nil, 10)
+	require.NoError(t, err)
+	assert.Len(t, documents, 2)
+
+	documents, err = dbClient.Document.GetExpiredDocuments(ctx, &entity.Document{CategoryID: category.ID}, before, []string{first.ID}, 10)
+	require.NoError(t, err)
+	require.Len(t, documents, 1)
+	assert.Equal(t, second.ID, documents[0].ID)
+
+	_, err = dbClient.Document.DeleteDocument(ctx, first)
+	require.NoError(t, err)
+	_, err = dbClient.Document.DeleteDocument(ctx, second)
+	require.NoError(t, err)
+
+	documents, err = dbClient.Document.GetDeletedDocumentsBefore(ctx, &entity.Document{CategoryID: category.ID}, before, []string{second.ID}, 10)
+	require.NoError(t, err)
+	require.Len(t, documents, 1)
+	assert.Equal(t, first.ID, documents[0].ID)
+}
+
+func TestDocumentRepositoryUpdateDocumentVersion(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	category := f.DocumentCategory().MustCreate(t)
+	other := f.DocumentCategory().MustCreate(t)
+	document := f.Document().WithCategory(category).MustCreate(t)
+	assert.Equal(t, int64(1), document.Version)
+
+	target := &entity.Document{ID: document.ID, Version: 1}
+	err := dbClient.Document.UpdateDocument(ctx, target, &entity.Document{CategoryID: other.ID, OriginalName: "moved.pdf"})
+	require.NoError(t, err)
+	assert.Equal(t, other.ID, target.CategoryID)
+	assert.Equal(t, "moved.pdf", target.OriginalName)
+	assert.Equal(t, int64(2), target.Version)
+
+	usage, err := dbClient.StorageUsage.FindStorageUsage(ctx, &entity.StorageUsage{
+		SubjectType: entity.StorageSubjectDocumentCategory,
+		SubjectID:   other.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), usage.UsedObjects)
+
+	// The stale version is refused and the document is not changed.
+	err = dbClient.Document.UpdateDocument(ctx, &entity.Document{ID: document.ID, Version: 1}, &entity.Document{OriginalName: "stale.pdf"})
+	assert.True(t, errors.Is(err, repository.ErrVersionConflict))
+
+	found, err := dbClient.Document.FindDocument(ctx, &entity.Document{ID: document.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "moved.pdf", found.OriginalName)
+
+	// The zero version updates the current version.
+	target = &entity.Document{ID: document.ID}
+	err = dbClient.Document.UpdateDocument(ctx, target, &entity.Document{OriginalName: "renamed.pdf"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), target.Version)
+}
+
+func TestDocumentRepositoryFindDocumentByEntity(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	document := f.Document().MustCreate(t)
+
+	found, err := dbClient.Document.FindDocumentByEntity(ctx, &entity.Document{Path: document.Path})
+	require.NoError(t, err)
+	assert.Equal(t, document.ID, found.ID)
+
+	_, err = dbClient.Document.FindDocumentByEntity(ctx, &entity.Document{Path: "missing.pdf"})
+	assert.True(t, errors.Is(err, exception.ErrNotFound))
+}
@@ -0,0 +1,155 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"micro/domain/entity"
+	"micro/domain/repository"
+	"micro/pkg/exception"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgconn"
+	"gorm.io/gorm"
+)
+
+// The SQL state of the Postgres errors which are translated.
+const (
+	postgresUniqueViolation     = "23505"
+	postgresForeignKeyViolation = "23503"
+	postgresNotNullViolation    = "23502"
+	postgresStringTooLong       = "22001"
+	postgresQueryCanceled       = "57014"
+	postgresTooManyConnections  = "53300"
+	postgresConnectionException = "08"
+)
+
+// The error number of the MySQL errors which are translated.
+const (
+	mysqlTooManyConnections = 1040
+	mysqlDuplicateEntry     = 1062
+	mysqlDataTooLong        = 1406
+	mysqlRowIsReferenced    = 1451
+	mysqlNoReferencedRow    = 1452
+)
+
+const sqliteUniqueViolation = "UNIQUE constraint failed: "
+
+// reMySQLDuplicateKey match the name of the index on the duplicate entry error of MySQL, the name is prefixed by
+// the table since MySQL 8.0.19, e.g. Duplicate entry 'invoice' for key 'document_categories.idx_slug'.
+var reMySQLDuplicateKey = regexp.MustCompile(`for key '(?:[^']*\.)?([^'.]+)'$`)
+
+// uniqueFields holds the field of the unique indexes which are not named by their column.
+var uniqueFields = map[string]string{
+	entity.DocumentCategorySlugIndex: "slug",
+}
+
+// translateError return the exception.Error of the driver error, so the repositories do not leak the errors of
+// gorm and the drivers. The unique violation is repository.ConflictError with its field. The error which is
+// already exception.Error and the error which is not known are returned as is. The driver error is kept as the
+// cause, so it still matches with errors.Is and errors.As.
+func translateError(err error) error {
+	var errDomain *exception.Error
+	if err == nil || errors.As(err, &errDomain) {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, sql.ErrNoRows):
+		return exception.Wrap(exception.KindNotFound, exception.ErrNotFound.Message, err)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return &repository.ConflictError{}
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone), errors.Is(err, mysql.ErrInvalidConn):
+		return exception.Wrap(exception.KindUnavailable, exception.ErrUnavailable.Message, err)
+	case errors.Is(err, context.Canceled):
+		return exception.Wrap(exception.KindCanceled, exception.ErrCanceled.Message, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return exception.Wrap(exception.KindDeadlineExceeded, exception.ErrDeadlineExceeded.Message, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return translatePostgresError(pgErr, err)
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return translateMySQLError(mysqlErr, err)
+	}
+
+	// The SQLite drivers do not share an error type, the message names the column and may be followed by the
+	// extended error code, e.g. UNIQUE constraint failed: document_categories.slug (2067).
+	if message := err.Error(); strings.Contains(message, sqliteUniqueViolation) {
+		column := message[strings.Index(message, sqliteUniqueViolation)+len(sqliteUniqueViolation):]
+		if end := strings.IndexAny(column, ", "); end >= 0 {
+			column = column[:end]
+		}
+
+		return &repository.ConflictError{Field: column[strings.LastIndex(column, ".")+1:]}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return exception.Wrap(exception.KindUnavailable, exception.ErrUnavailable.Message, err)
+	}
+
+	return err
+}
+
+// translatePostgresError return the exception.Error of the Postgres error by its SQL state.
+func translatePostgresError(pgErr *pgconn.PgError, err error) error {
+	switch {
+	case pgErr.Code == postgresUniqueViolation:
+		return &repository.ConflictError{Field: uniqueField(pgErr.ConstraintName)}
+	case pgErr.Code == postgresForeignKeyViolation:
+		return exception.Wrap(exception.KindFailedPrecondition, exception.ErrFailedPrecondition.Message, err)
+	case pgErr.Code == postgresNotNullViolation, pgErr.Code == postgresStringTooLong:
+		return exception.Wrap(exception.KindValidation, exception.ErrValidation.Message, err)
+	case pgErr.Code == postgresSerializationFailure, pgErr.Code == postgresDeadlockDetected:
+		// The transaction is already retried by WithTransaction, the client may retry it later.
+		return exception.Wrap(exception.KindUnavailable, exception.ErrUnavailable.Message, err)
+	case pgErr.Code == postgresQueryCanceled:
+		return exception.Wrap(exception.KindDeadlineExceeded, exception.ErrDeadlineExceeded.Message, err)
+	case pgErr.Code == postgresTooManyConnections, strings.HasPrefix(pgErr.Code, postgresConnectionException):
+		return exception.Wrap(exception.KindUnavailable, exception.ErrUnavailable.Message, err)
+	default:
+		return err
+	}
+}
+
+// translateMySQLError return the exception.Error of the MySQL error by its error number.
+func translateMySQLError(mysqlErr *mysql.MySQLError, err error) error {
+	switch mysqlErr.Number {
+	case mysqlDuplicateEntry:
+		var index string
+		if matches := reMySQLDuplicateKey.FindStringSubmatch(mysqlErr.Message); matches != nil {
+			index = matches[1]
+		}
+
+		return &repository.ConflictError{Field: uniqueField(index)}
+	case mysqlRowIsReferenced, mysqlNoReferencedRow:
+		return exception.Wrap(exception.KindFailedPrecondition, exception.ErrFailedPrecondition.Message, err)
+	case mysqlDataTooLong:
+		return exception.Wrap(exception.KindValidation, exception.ErrValidation.Message, err)
+	case mysqlDeadlock, mysqlLockWaitTimeout:
+		// The transaction is already retried by WithTransaction, the client may retry it later.
+		return exception.Wrap(exception.KindUnavailable, exception.ErrUnavailable.Message, err)
+	case mysqlTooManyConnections:
+		return exception.Wrap(exception.KindUnavailable, exception.ErrUnavailable.Message, err)
+	default:
+		return err
+	}
+}
+
+// uniqueField return the field of the unique index, the index which is not known is returned as is.
+func uniqueField(index string) string {
+	if field, ok := uniqueFields[index]; ok {
+		return field
+	}
+
+	return index
+}
@@ -0,0 +1,118 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"micro/domain/entity"
+	"micro/domain/repository"
+	"micro/pkg/exception"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestTranslateError(t *testing.T) {
+	testCases := []struct {
+		name  string
+		err   error
+		field string
+	}{
+		{
+			name:  "postgres",
+			err:   &pgconn.PgError{Code: postgresUniqueViolation, ConstraintName: entity.DocumentCategorySlugIndex},
+			field: "slug",
+		},
+		{
+			name:  "postgres unknown constraint",
+			err:   &pgconn.PgError{Code: postgresUniqueViolation, ConstraintName: "document_categories_pkey"},
+			field: "document_categories_pkey",
+		},
+		{
+			name:  "mysql",
+			err:   &mysql.MySQLError{Number: mysqlDuplicateEntry, Message: "Duplicate entry 'invoice' for key 'document_categories." + entity.DocumentCategorySlugIndex + "'"},
+			field: "slug",
+		},
+		{
+			name:  "mysql without table",
+			err:   &mysql.MySQLError{Number: mysqlDuplicateEntry, Message: "Duplicate entry 'invoice' for key '" + entity.DocumentCategorySlugIndex + "'"},
+			field: "slug",
+		},
+		{
+			name:  "sqlite",
+			err:   errors.New("UNIQUE constraint failed: document_categories.slug"),
+			field: "slug",
+		},
+		{
+			name:  "sqlite with extended code",
+			err:   errors.New("UNIQUE constraint failed: document_categories.slug (2067)"),
+			field: "slug",
+		},
+		{
+			name:  "wrapped",
+			err:   fmt.Errorf("save: %w", &pgconn.PgError{Code: postgresUniqueViolation, ConstraintName: entity.DocumentCategorySlugIndex}),
+			field: "slug",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := translateError(tc.err)
+
+			var errConflict *repository.ConflictError
+			assert.True(t, errors.As(err, &errConflict))
+			assert.True(t, errors.Is(err, repository.ErrConflict))
+			assert.Equal(t, tc.field, errConflict.Field)
+		})
+	}
+
+	assert.NoError(t, translateError(nil))
+	assert.Equal(t, repository.ErrVersionConflict, translateError(repository.ErrVersionConflict))
+
+	errUnknown := fmt.Errorf("wrapped: %w", errors.New("unknown"))
+	assert.Equal(t, errUnknown, translateError(errUnknown))
+}
+
+func TestTranslateErrorKind(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		kind exception.Kind
+	}{
+		{name: "record not found", err: gorm.ErrRecordNotFound, kind: exception.KindNotFound},
+		{name: "no rows", err: sql.ErrNoRows, kind: exception.KindNotFound},
+		{name: "bad connection", err: driver.ErrBadConn, kind: exception.KindUnavailable},
+		{name: "context canceled", err: context.Canceled, kind: exception.KindCanceled},
+		{name: "context deadline exceeded", err: context.DeadlineExceeded, kind: exception.KindDeadlineExceeded},
+		{name: "postgres foreign key", err: &pgconn.PgError{Code: postgresForeignKeyViolation}, kind: exception.KindFailedPrecondition},
+		{name: "postgres not null", err: &pgconn.PgError{Code: postgresNotNullViolation}, kind: exception.KindValidation},
+		{name: "postgres deadlock", err: &pgconn.PgError{Code: postgresDeadlockDetected}, kind: exception.KindUnavailable},
+		{name: "postgres serialization failure", err: &pgconn.PgError{Code: postgresSerializationFailure}, kind: exception.KindUnavailable},
+		{name: "postgres query canceled", err: &pgconn.PgError{Code: postgresQueryCanceled}, kind: exception.KindDeadlineExceeded},
+		{name: "postgres connection failure", err: &pgconn.PgError{Code: "08006"}, kind: exception.KindUnavailable},
+		{name: "mysql foreign key", err: &mysql.MySQLError{Number: mysqlNoReferencedRow}, kind: exception.KindFailedPrecondition},
+		{name: "mysql data too long", err: &mysql.MySQLError{Number: mysqlDataTooLong}, kind: exception.KindValidation},
+		{name: "mysql deadlock", err: &mysql.MySQLError{Number: mysqlDeadlock}, kind: exception.KindUnavailable},
+		{name: "mysql lock wait timeout", err: &mysql.MySQLError{Number: mysqlLockWaitTimeout}, kind: exception.KindUnavailable},
+		{name: "mysql too many connections", err: &mysql.MySQLError{Number: mysqlTooManyConnections}, kind: exception.KindUnavailable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := translateError(tc.err)
+
+			var errDomain *exception.Error
+			assert.True(t, errors.As(err, &errDomain))
+			assert.Equal(t, tc.kind, errDomain.Kind)
+			assert.True(t, errors.Is(err, tc.err))
+		})
+	}
+
+	// The deadlock is still retried by WithTransaction after it is translated.
+	assert.True(t, isRetryableTransactionError(translateError(&pgconn.PgError{Code: postgresDeadlockDetected})))
+}
@@ -0,0 +1,158 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"micro/domain/entity"
+	"micro/domain/repository"
+	"micro/pkg/parameter"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LegalHoldRepo is a struct to store db connection.
+type LegalHoldRepo struct {
+	db *gorm.DB
+}
+
+// NewLegalHoldRepository will initialize LegalHoldRepo repository.
+func NewLegalHoldRepository(db *gorm.DB) *LegalHoldRepo {
+	return &LegalHoldRepo{db}
+}
+
+// LegalHoldRepo implements the repository.LegalHoldRepositoryInterface.
+var _ repository.LegalHoldRepositoryInterface = &LegalHoldRepo{}
+
+// GetLegalHoldAudits will get legal hold audits from the database storage.
+func (f *LegalHoldRepo) GetLegalHoldAudits(ctx context.Context, q *parameter.SQLQueryParameters) (entity.LegalHoldAudits, *parameter.ResponseMetadata, error) {
+	var dataEntities entity.LegalHoldAudits
+
+	meta, err := paginate(f.db.WithContext(ctx), q, &dataEntities)
+	if err != nil {
+		return nil, nil, translateError(err)
+	}
+
+	return dataEntities, meta, nil
+}
+
+// PlaceLegalHold will place a legal hold on the subject and record the audit in a single transaction.
+func (f *LegalHoldRepo) PlaceLegalHold(ctx context.Context, r *entity.LegalHoldAudit) (*entity.LegalHoldAudit, error) {
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		model, onHold, err := takeLegalHoldSubject(tx, r)
+		if err != nil {
+			return err
+		}
+
+		if onHold {
+			return repository.ErrLegalHoldActive
+		}
+
+		err = tx.Unscoped().Model(model).Where("id = ?", r.SubjectID).Updates(map[string]interface{}{
+			"legal_hold":        true,
+			"legal_hold_reason": r.Reason,
+			"legal_hold_by":     r.Actor,
+			"legal_hold_at":     time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		r.Action = entity.LegalHoldActionPlace
+
+		return tx.Create(r).Error
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return r, nil
+}
+
+// ReleaseLegalHold will release the legal hold of the subject and record the audit in a single transaction.
+func (f *LegalHoldRepo) ReleaseLegalHold(ctx context.Context, r *entity.LegalHoldAudit) (*entity.LegalHoldAudit, error) {
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		model, onHold, err := takeLegalHoldSubject(tx, r)
+		if err != nil {
+			return err
+		}
+
+		if !onHold {
+			return repository.ErrLegalHoldNotActive
+		}
+
+		err = tx.Unscoped().Model(model).Where("id = ?", r.SubjectID).Updates(map[string]interface{}{
+			"legal_hold":        false,
+			"legal_hold_reason": "",
+			"legal_hold_by":     "",
+			"legal_hold_at":     nil,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		r.Action = entity.LegalHoldActionRelease
+
+		return tx.Create(r).Error
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return r, nil
+}
+
+// takeLegalHoldSubject lock the subject row and return its model and current legal hold state.
+// The soft-deleted subject is taken too, so the trashed data can be held before it is purged.
+// The category of the Document is locked too, so the category is not deleted while its Document is held.
+func takeLegalHoldSubject(tx *gorm.DB, r *entity.LegalHoldAudit) (interface{}, bool, error) {
+	var model interface{}
+	var subject struct {
+		LegalHold  bool
+		CategoryID string
+	}
+
+	columns := []string{"legal_hold"}
+	switch r.SubjectType {
+	case entity.LegalHoldSubjectDocument:
+		model = &entity.Document{}
+		columns = append(columns, "category_id")
+	case entity.LegalHoldSubjectDocumentCategory:
+		model = &entity.DocumentCategory{}
+	default:
+		return nil, false, repository.ErrLegalHoldInvalidSubjectType
+	}
+
+	err := tx.Unscoped().Model(model).Clauses(clause.Locking{Strength: "UPDATE"}).Select(columns).Where("id = ?", r.SubjectID).Take(&subject).Error
+	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, repository.ErrLegalHoldSubjectNotFound
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if subject.CategoryID != "" {
+		_, err = lockDocumentCategory(tx, subject.CategoryID)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	return model, subject.LegalHold, nil
+}
+
+// lockDocumentCategory lock the Document category row and return whether it is on legal hold.
+// The soft-deleted category is locked too, the category which does not exist is not on legal hold.
+func lockDocumentCategory(tx *gorm.DB, id string) (bool, error) {
+	var categories []struct {
+		LegalHold bool
+	}
+
+	err := tx.Unscoped().Model(&entity.DocumentCategory{}).Clauses(clause.Locking{Strength: "UPDATE"}).Select("legal_hold").Where("id = ?", id).Find(&categories).Error
+	if err != nil {
+		return false, err
+	}
+
+	return len(categories) > 0 && categories[0].LegalHold, nil
+}
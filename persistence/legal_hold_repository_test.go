@@ -0,0 +1,80 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"micro/domain/entity"
+	"micro/domain/factory"
+	"micro/domain/repository"
+	"micro/pkg/filestore/driver/memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegalHoldRepositoryPlaceOnTrashedSubject(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	category := f.DocumentCategory().WithRetention(1, 0).MustCreate(t)
+	document := f.Document().WithCategory(category).MustCreate(t)
+
+	_, err := dbClient.Document.DeleteDocument(ctx, document)
+	require.NoError(t, err)
+
+	_, err = dbClient.LegalHold.PlaceLegalHold(ctx, &entity.LegalHoldAudit{
+		SubjectType: entity.LegalHoldSubjectDocument,
+		SubjectID:   document.ID,
+		Reason:      "litigation",
+		Actor:       "legal",
+	})
+	require.NoError(t, err)
+
+	// The trashed document on hold is not purged.
+	documents, err := dbClient.Document.GetDeletedDocumentsBefore(ctx, &entity.Document{CategoryID: category.ID}, time.Now().Add(time.Minute), nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, documents)
+
+	_, err = dbClient.LegalHold.PlaceLegalHold(ctx, &entity.LegalHoldAudit{
+		SubjectType: entity.LegalHoldSubjectDocument,
+		SubjectID:   document.ID,
+		Reason:      "litigation",
+		Actor:       "legal",
+	})
+	assert.True(t, errors.Is(err, repository.ErrLegalHoldActive))
+
+	_, err = dbClient.LegalHold.ReleaseLegalHold(ctx, &entity.LegalHoldAudit{
+		SubjectType: entity.LegalHoldSubjectDocument,
+		SubjectID:   document.ID,
+		Reason:      "settled",
+		Actor:       "legal",
+	})
+	require.NoError(t, err)
+
+	documents, err = dbClient.Document.GetDeletedDocumentsBefore(ctx, &entity.Document{CategoryID: category.ID}, time.Now().Add(time.Minute), nil, 10)
+	require.NoError(t, err)
+	require.Len(t, documents, 1)
+	assert.Equal(t, document.ID, documents[0].ID)
+
+	_, err = dbClient.DocumentCategory.DeleteDocumentCategory(ctx, category)
+	require.NoError(t, err)
+
+	_, err = dbClient.LegalHold.PlaceLegalHold(ctx, &entity.LegalHoldAudit{
+		SubjectType: entity.LegalHoldSubjectDocumentCategory,
+		SubjectID:   category.ID,
+		Reason:      "litigation",
+		Actor:       "legal",
+	})
+	assert.NoError(t, err)
+
+	_, err = dbClient.LegalHold.PlaceLegalHold(ctx, &entity.LegalHoldAudit{
+		SubjectType: entity.LegalHoldSubjectDocument,
+		SubjectID:   "unknown",
+		Reason:      "litigation",
+		Actor:       "legal",
+	})
+	assert.True(t, errors.Is(err, repository.ErrLegalHoldSubjectNotFound))
+}
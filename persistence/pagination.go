@@ -0,0 +1,102 @@
+package persistence
+
+import (
+	"fmt"
+	"micro/pkg/parameter"
+	"reflect"
+
+	"gorm.io/gorm"
+)
+
+// paginate will find the rows into dest based on the query parameters and build the response metadata.
+// The rows are fetched by offset or, when the cursor is given, by keyset. The total is counted unless it is skipped.
+func paginate(db *gorm.DB, q *parameter.SQLQueryParameters, dest interface{}) (*parameter.ResponseMetadata, error) {
+	var total int64
+
+	q = q.ForDialect(db.Dialector.Name())
+	query := db.Where(q.QueryKey, q.QueryValue...).Where(q.DateRange).Session(&gorm.Session{})
+	if !q.SkipTotal {
+		errTotal := query.Model(dest).Count(&total).Error
+		if errTotal != nil {
+			return nil, errTotal
+		}
+	}
+
+	list := query
+	if q.CursorKey != "" {
+		list = list.Where(q.CursorKey, q.CursorValue...)
+	}
+
+	if fields, ok := newDestElem(dest).(parameter.Fields); ok && len(q.Fields) > 0 {
+		list = list.Select(q.SelectColumns(fields))
+	}
+
+	// One more row is fetched to know whether there are more rows after the page.
+	tx := list.Order(q.Order).Limit(q.Limit + 1).Offset(q.Offset).Find(dest)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	rows := reflect.ValueOf(dest).Elem()
+	hasMore := rows.Len() > q.Limit
+	if hasMore {
+		rows.Set(rows.Slice(0, q.Limit))
+	}
+
+	isPrev := q.Cursor != nil && q.Cursor.Prev
+	if isPrev {
+		reverseRows(rows)
+	}
+
+	meta := parameter.NewMeta(q, total)
+	if rows.Len() == 0 {
+		return meta, nil
+	}
+
+	var next, prev *parameter.Cursor
+	if hasMore || isPrev {
+		next = newCursor(tx, q.CursorColumns, rows.Index(rows.Len()-1), false)
+	}
+	if (isPrev && hasMore) || (!isPrev && (q.Cursor != nil || q.Offset > 0)) {
+		prev = newCursor(tx, q.CursorColumns, rows.Index(0), true)
+	}
+
+	return meta.WithCursors(next, prev), nil
+}
+
+// newCursor build the cursor of the row from the values of the cursor columns and the ID.
+func newCursor(tx *gorm.DB, columns []string, row reflect.Value, isPrev bool) *parameter.Cursor {
+	cursor := &parameter.Cursor{Prev: isPrev}
+	for _, column := range columns {
+		var value interface{}
+		if field := tx.Statement.Schema.LookUpField(column); field != nil {
+			value, _ = field.ValueOf(tx.Statement.Context, row)
+		}
+		cursor.Values = append(cursor.Values, value)
+	}
+
+	if field := tx.Statement.Schema.PrioritizedPrimaryField; field != nil {
+		id, _ := field.ValueOf(tx.Statement.Context, row)
+		cursor.ID = fmt.Sprint(id)
+	}
+
+	return cursor
+}
+
+// newDestElem return a new element of dest, dest is a pointer to a slice of pointer, e.g. *entity.Documents.
+func newDestElem(dest interface{}) interface{} {
+	elemType := reflect.TypeOf(dest).Elem().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+
+	return reflect.New(elemType).Interface()
+}
+
+// reverseRows reverse the order of the rows in place.
+func reverseRows(rows reflect.Value) {
+	swap := reflect.Swapper(rows.Interface())
+	for i, j := 0, rows.Len()-1; i < j; i, j = i+1, j-1 {
+		swap(i, j)
+	}
+}
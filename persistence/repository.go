@@ -0,0 +1,128 @@
+package persistence
+
+import (
+	"context"
+	"micro/domain/entity"
+	"micro/pkg/parameter"
+
+	"gorm.io/gorm"
+)
+
+// Repository is a generic repository which provides the list, count, find, save, update and delete of the entity T.
+// PT is the pointer of T which implements entity.Interface, it is inferred from T, e.g.
+//
+//	NewRepository[entity.DocumentCategory](db)
+//
+// The entity repositories embed it and keep their domain-specific methods on top.
+type Repository[T any, PT interface {
+	*T
+	entity.Interface
+}] struct {
+	db *gorm.DB
+}
+
+// NewRepository will initialize the generic repository of the entity T.
+func NewRepository[T any, PT interface {
+	*T
+	entity.Interface
+}](db *gorm.DB) *Repository[T, PT] {
+	return &Repository[T, PT]{db}
+}
+
+// Count will count the entities which match the query parameters in the database storage.
+func (f *Repository[T, PT]) Count(ctx context.Context, q *parameter.SQLQueryParameters) (int64, error) {
+	var total int64
+
+	q = q.ForDialect(f.db.Dialector.Name())
+	err := f.db.WithContext(ctx).Model(PT(new(T))).Where(q.QueryKey, q.QueryValue...).Where(q.DateRange).Count(&total).Error
+	if err != nil {
+		return 0, translateError(err)
+	}
+
+	return total, nil
+}
+
+// Get will get the entities which match the query parameters from the database storage.
+func (f *Repository[T, PT]) Get(ctx context.Context, q *parameter.SQLQueryParameters) ([]*T, *parameter.ResponseMetadata, error) {
+	var dataEntities []*T
+
+	meta, err := paginate(f.db.WithContext(ctx), q, &dataEntities)
+	if err != nil {
+		return nil, nil, translateError(err)
+	}
+
+	return dataEntities, meta, nil
+}
+
+// GetDeleted will get the soft-deleted entities which match the query parameters from the database storage.
+func (f *Repository[T, PT]) GetDeleted(ctx context.Context, q *parameter.SQLQueryParameters) ([]*T, *parameter.ResponseMetadata, error) {
+	var dataEntities []*T
+
+	meta, err := paginate(f.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL"), q, &dataEntities)
+	if err != nil {
+		return nil, nil, translateError(err)
+	}
+
+	return dataEntities, meta, nil
+}
+
+// Find will find the entity by the id from the database storage.
+func (f *Repository[T, PT]) Find(ctx context.Context, id interface{}) (*T, error) {
+	var dataEntity T
+
+	err := f.db.WithContext(ctx).Where("id = ?", id).Take(&dataEntity).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &dataEntity, nil
+}
+
+// FindBy will find the entity which match the non-zero fields of the conditions from the database storage.
+func (f *Repository[T, PT]) FindBy(ctx context.Context, conditions *T) (*T, error) {
+	var dataEntity T
+
+	err := f.db.WithContext(ctx).Where(conditions).Take(&dataEntity).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &dataEntity, nil
+}
+
+// Save will save the entity into the database storage.
+func (f *Repository[T, PT]) Save(ctx context.Context, r *T) (*T, error) {
+	err := f.db.WithContext(ctx).Create(r).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return r, nil
+}
+
+// Update will update the non-zero fields of the entity with the id in the database storage.
+func (f *Repository[T, PT]) Update(ctx context.Context, id interface{}, value *T) (*T, error) {
+	var dataEntity T
+
+	err := f.db.WithContext(ctx).Model(&dataEntity).Where("id = ?", id).Updates(value).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return f.Find(ctx, id)
+}
+
+// Delete will delete the entity with the id from the database storage.
+func (f *Repository[T, PT]) Delete(ctx context.Context, id interface{}) (*T, error) {
+	dataEntity, err := f.Find(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	err = f.db.WithContext(ctx).Delete(dataEntity).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return dataEntity, nil
+}
@@ -0,0 +1,118 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"micro/domain/entity"
+	"micro/domain/factory"
+	"micro/persistence"
+	"micro/pkg/exception"
+	"micro/pkg/filestore/driver/memory"
+	"micro/pkg/parameter"
+	commonv1 "micro/transport/grpc/common/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestQuery return SQLQueryParameters of the typed query on the fields of the entity.
+func newTestQuery(t *testing.T, query *commonv1.Query, fields parameter.Fields) *parameter.SQLQueryParameters {
+	t.Helper()
+
+	sqlParameters, violations := parameter.NewRPCQueryParameters(query, fields)
+	require.Empty(t, violations)
+
+	return sqlParameters
+}
+
+func TestRepositoryCRUD(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+	repo := persistence.NewRepository[entity.DocumentCategory](dbClient.DB)
+
+	category, err := repo.Save(ctx, f.DocumentCategory().WithSlug("invoice").Build())
+	require.NoError(t, err)
+
+	found, err := repo.Find(ctx, category.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "invoice", found.Slug)
+
+	found, err = repo.FindBy(ctx, &entity.DocumentCategory{Slug: "invoice"})
+	require.NoError(t, err)
+	assert.Equal(t, category.ID, found.ID)
+
+	updated, err := repo.Update(ctx, category.ID, &entity.DocumentCategory{Name: "Invoice"})
+	require.NoError(t, err)
+	assert.Equal(t, "Invoice", updated.Name)
+	assert.Equal(t, "invoice", updated.Slug)
+
+	deleted, err := repo.Delete(ctx, category.ID)
+	require.NoError(t, err)
+	assert.Equal(t, category.ID, deleted.ID)
+
+	_, err = repo.Find(ctx, category.ID)
+	assert.True(t, errors.Is(err, exception.ErrNotFound))
+
+	_, err = repo.Delete(ctx, category.ID)
+	assert.True(t, errors.Is(err, exception.ErrNotFound))
+
+	trashed, _, err := repo.GetDeleted(ctx, newTestQuery(t, &commonv1.Query{}, &entity.DocumentCategory{}))
+	require.NoError(t, err)
+	require.Len(t, trashed, 1)
+	assert.Equal(t, category.ID, trashed[0].ID)
+}
+
+func TestRepositoryGetAndCount(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+	repo := persistence.NewRepository[entity.DocumentCategory](dbClient.DB)
+	fields := &entity.DocumentCategory{}
+
+	for _, slug := range []string{"a", "b", "c", "d", "e"} {
+		_, err := repo.Save(ctx, f.DocumentCategory().WithSlug(slug).Build())
+		require.NoError(t, err)
+	}
+
+	byPage := newTestQuery(t, &commonv1.Query{
+		Pagination: &commonv1.Pagination{Page: 2, PerPage: 2},
+		Sort:       []*commonv1.SortField{{Field: "slug"}},
+	}, fields)
+	categories, meta, err := repo.Get(ctx, byPage)
+	require.NoError(t, err)
+	require.Len(t, categories, 2)
+	assert.Equal(t, "c", categories[0].Slug)
+	assert.Equal(t, "d", categories[1].Slug)
+	assert.Equal(t, int64(5), meta.GetTotal())
+
+	var slugs []string
+	cursor := ""
+	for {
+		byCursor := newTestQuery(t, &commonv1.Query{
+			Pagination: &commonv1.Pagination{PerPage: 2, Cursor: cursor, SkipTotal: true},
+			Sort:       []*commonv1.SortField{{Field: "slug", Desc: true}},
+		}, fields)
+		categories, meta, err = repo.Get(ctx, byCursor)
+		require.NoError(t, err)
+		assert.Nil(t, meta.Total)
+
+		for _, category := range categories {
+			slugs = append(slugs, category.Slug)
+		}
+
+		if meta.NextCursor == "" {
+			break
+		}
+		cursor = meta.NextCursor
+	}
+	assert.Equal(t, []string{"e", "d", "c", "b", "a"}, slugs)
+
+	filtered := newTestQuery(t, &commonv1.Query{
+		Filters: []*commonv1.Filter{{Field: "slug", Operator: commonv1.FilterOperator_FILTER_OPERATOR_IN, Values: []string{"a", "e"}}},
+	}, fields)
+	total, err := repo.Count(ctx, filtered)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+}
@@ -0,0 +1,189 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"micro/domain/entity"
+	"micro/domain/repository"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StorageUsageRepo is a struct to store db connection.
+type StorageUsageRepo struct {
+	db *gorm.DB
+}
+
+// NewStorageUsageRepository will initialize StorageUsageRepo repository.
+func NewStorageUsageRepository(db *gorm.DB) *StorageUsageRepo {
+	return &StorageUsageRepo{db}
+}
+
+// StorageUsageRepo implements the repository.StorageUsageRepositoryInterface.
+var _ repository.StorageUsageRepositoryInterface = &StorageUsageRepo{}
+
+// FindStorageUsage will find storage usage of the subject from the database storage.
+// A subject which is not tracked yet return an empty usage.
+func (f *StorageUsageRepo) FindStorageUsage(ctx context.Context, r *entity.StorageUsage) (*entity.StorageUsage, error) {
+	dataEntity := entity.StorageUsage{SubjectType: r.SubjectType, SubjectID: r.SubjectID}
+
+	err := f.db.WithContext(ctx).Where("subject_type = ? AND subject_id = ?", r.SubjectType, r.SubjectID).Take(&dataEntity).Error
+	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
+		return &dataEntity, nil
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &dataEntity, nil
+}
+
+// CheckDocumentCategoryQuota will check whether storing the given bytes and objects exceeds the category quotas.
+// It does not lock the usage, so the final check is done again when the document is saved.
+func (f *StorageUsageRepo) CheckDocumentCategoryQuota(ctx context.Context, category *entity.DocumentCategory, bytes int64, objects int64) error {
+	if !category.HasStorageQuota() {
+		return nil
+	}
+
+	usage, err := f.FindStorageUsage(ctx, &entity.StorageUsage{SubjectType: entity.StorageSubjectDocumentCategory, SubjectID: category.ID})
+	if err != nil {
+		return translateError(err)
+	}
+
+	return checkDocumentCategoryQuota(category, usage, bytes, objects)
+}
+
+// RecalculateStorageUsages will recalculate the storage usage of every category from its documents.
+// Soft-deleted documents are counted until they are purged since their objects are still stored.
+func (f *StorageUsageRepo) RecalculateStorageUsages(ctx context.Context) error {
+	var usages []struct {
+		CategoryID  string
+		UsedBytes   int64
+		UsedObjects int64
+	}
+
+	err := f.db.WithContext(ctx).Unscoped().Model(entity.Document{}).
+		Select("category_id, COALESCE(SUM(size), 0) AS used_bytes, COUNT(*) AS used_objects").
+		Group("category_id").
+		Scan(&usages).Error
+	if err != nil {
+		return translateError(err)
+	}
+
+	err = f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(entity.StorageUsage{}).Where("subject_type = ?", entity.StorageSubjectDocumentCategory).Updates(map[string]interface{}{
+			"used_bytes":   0,
+			"used_objects": 0,
+			"updated_at":   time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		for _, usage := range usages {
+			err = tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "subject_type"}, {Name: "subject_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"used_bytes", "used_objects", "updated_at"}),
+			}).Create(&entity.StorageUsage{
+				SubjectType: entity.StorageSubjectDocumentCategory,
+				SubjectID:   usage.CategoryID,
+				UsedBytes:   usage.UsedBytes,
+				UsedObjects: usage.UsedObjects,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return translateError(err)
+}
+
+// lockStorageUsage take the storage usage of the subject with a row lock, it is tracked first when it does not exist.
+func lockStorageUsage(tx *gorm.DB, subjectType string, subjectID string) (*entity.StorageUsage, error) {
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entity.StorageUsage{
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var dataEntity entity.StorageUsage
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("subject_type = ? AND subject_id = ?", subjectType, subjectID).Take(&dataEntity).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &dataEntity, nil
+}
+
+// adjustStorageUsage add the given bytes and objects to the storage usage of the subject, use negative values to release.
+func adjustStorageUsage(tx *gorm.DB, subjectType string, subjectID string, bytes int64, objects int64) error {
+	return tx.Model(entity.StorageUsage{}).Where("subject_type = ? AND subject_id = ?", subjectType, subjectID).Updates(map[string]interface{}{
+		"used_bytes":   gorm.Expr("used_bytes + ?", bytes),
+		"used_objects": gorm.Expr("used_objects + ?", objects),
+		"updated_at":   time.Now(),
+	}).Error
+}
+
+// checkDocumentCategoryQuota return repository.QuotaExceededError when the usage plus the requested bytes and objects
+// exceed the category quotas. A zero quota is unlimited.
+func checkDocumentCategoryQuota(category *entity.DocumentCategory, usage *entity.StorageUsage, bytes int64, objects int64) error {
+	var violations []repository.QuotaViolation
+
+	if category.QuotaBytes > 0 && bytes > 0 && usage.UsedBytes+bytes > category.QuotaBytes {
+		violations = append(violations, repository.QuotaViolation{
+			SubjectType: entity.StorageSubjectDocumentCategory,
+			SubjectID:   category.ID,
+			Quota:       entity.StorageQuotaBytes,
+			Limit:       category.QuotaBytes,
+			Used:        usage.UsedBytes,
+			Requested:   bytes,
+		})
+	}
+
+	if category.QuotaObjects > 0 && objects > 0 && usage.UsedObjects+objects > category.QuotaObjects {
+		violations = append(violations, repository.QuotaViolation{
+			SubjectType: entity.StorageSubjectDocumentCategory,
+			SubjectID:   category.ID,
+			Quota:       entity.StorageQuotaObjects,
+			Limit:       category.QuotaObjects,
+			Used:        usage.UsedObjects,
+			Requested:   objects,
+		})
+	}
+
+	if len(violations) > 0 {
+		return &repository.QuotaExceededError{Violations: violations}
+	}
+
+	return nil
+}
+
+// reserveDocumentCategoryStorage lock the storage usage of the category, check the quotas
+// then add the given bytes and objects. Negative values are released without checking.
+func reserveDocumentCategoryStorage(tx *gorm.DB, categoryID string, bytes int64, objects int64) error {
+	var category entity.DocumentCategory
+
+	err := tx.Where("id = ?", categoryID).Take(&category).Error
+	if err != nil {
+		return err
+	}
+
+	usage, err := lockStorageUsage(tx, entity.StorageSubjectDocumentCategory, category.ID)
+	if err != nil {
+		return err
+	}
+
+	err = checkDocumentCategoryQuota(&category, usage, bytes, objects)
+	if err != nil {
+		return err
+	}
+
+	return adjustStorageUsage(tx, entity.StorageSubjectDocumentCategory, category.ID, bytes, objects)
+}
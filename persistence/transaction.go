@@ -0,0 +1,76 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+const (
+	maxTransactionAttempts = 3
+	transactionRetryDelay  = 50 * time.Millisecond
+)
+
+// The SQL state and the error number of the transaction failures which can be retried.
+const (
+	postgresSerializationFailure = "40001"
+	postgresDeadlockDetected     = "40P01"
+	mysqlDeadlock                = 1213
+	mysqlLockWaitTimeout         = 1205
+)
+
+// WithTransaction will run fn in a transaction with the repositories bound to it.
+// The transaction is committed when fn returns nil, otherwise it is rolled back and the error is returned.
+// The transaction is retried when it fails on a serialization or a deadlock error, so fn must be safe to run again.
+// The error is translated to exception.Error as the errors of the repositories.
+// The nested call runs in a savepoint of the outer transaction and is not retried, the outer transaction is.
+func (c *DBClient) WithTransaction(ctx context.Context, fn func(tx *DBClient) error) error {
+	run := func() error {
+		return c.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return fn(NewDBService(tx))
+		})
+	}
+
+	if isTransaction(c.DB) {
+		return translateError(run())
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := run()
+		if err == nil || attempt >= maxTransactionAttempts || !isRetryableTransactionError(err) {
+			return translateError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return translateError(ctx.Err())
+		case <-time.After(time.Duration(attempt) * transactionRetryDelay):
+		}
+	}
+}
+
+// isTransaction return true when the db is bound to a transaction.
+func isTransaction(db *gorm.DB) bool {
+	committer, ok := db.Statement.ConnPool.(gorm.TxCommitter)
+
+	return ok && committer != nil
+}
+
+// isRetryableTransactionError return true when the transaction failed on a serialization or a deadlock error.
+func isRetryableTransactionError(err error) bool {
+	var pgErr interface{ SQLState() string }
+	if errors.As(err, &pgErr) {
+		state := pgErr.SQLState()
+		return state == postgresSerializationFailure || state == postgresDeadlockDetected
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlDeadlock || mysqlErr.Number == mysqlLockWaitTimeout
+	}
+
+	return false
+}
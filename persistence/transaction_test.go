@@ -0,0 +1,149 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"micro/domain/entity"
+	"micro/domain/factory"
+	"micro/persistence"
+	"micro/pkg/exception"
+	"micro/pkg/filestore/driver/memory"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBClientWithTransactionCommitAndRollback(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	committed := f.DocumentCategory().Build()
+	err := dbClient.WithTransaction(ctx, func(tx *persistence.DBClient) error {
+		_, err := tx.DocumentCategory.SaveDocumentCategory(ctx, committed)
+		return err
+	})
+	require.NoError(t, err)
+
+	_, err = dbClient.DocumentCategory.FindDocumentCategory(ctx, committed)
+	assert.NoError(t, err)
+
+	rolledBack := f.DocumentCategory().Build()
+	errFn := errors.New("failed")
+	err = dbClient.WithTransaction(ctx, func(tx *persistence.DBClient) error {
+		_, err := tx.DocumentCategory.SaveDocumentCategory(ctx, rolledBack)
+		require.NoError(t, err)
+
+		return errFn
+	})
+	assert.True(t, errors.Is(err, errFn))
+
+	_, err = dbClient.DocumentCategory.FindDocumentCategory(ctx, rolledBack)
+	assert.True(t, errors.Is(err, exception.ErrNotFound))
+}
+
+func TestDBClientWithTransactionRetry(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+
+	testCases := []struct {
+		name     string
+		err      error
+		attempts int
+		target   error
+	}{
+		{name: "postgres serialization failure", err: &pgconn.PgError{Code: "40001"}, attempts: 3},
+		{name: "postgres deadlock", err: &pgconn.PgError{Code: "40P01"}, attempts: 3},
+		{name: "mysql deadlock", err: &mysql.MySQLError{Number: 1213}, attempts: 3},
+		{name: "mysql lock wait timeout", err: &mysql.MySQLError{Number: 1205}, attempts: 3},
+		{name: "postgres unique violation", err: &pgconn.PgError{Code: "23505"}, attempts: 1, target: exception.ErrConflict},
+		{name: "mysql duplicate entry", err: &mysql.MySQLError{Number: 1062}, attempts: 1, target: exception.ErrConflict},
+		{name: "other error", err: errors.New("failed"), attempts: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts int
+			err := dbClient.WithTransaction(ctx, func(tx *persistence.DBClient) error {
+				attempts++
+				return tc.err
+			})
+
+			target := tc.target
+			if target == nil {
+				target = tc.err
+			}
+
+			assert.Equal(t, tc.attempts, attempts)
+			assert.True(t, errors.Is(err, target))
+		})
+	}
+
+	t.Run("succeed on the retry", func(t *testing.T) {
+		var attempts int
+		err := dbClient.WithTransaction(ctx, func(tx *persistence.DBClient) error {
+			attempts++
+			if attempts == 1 {
+				return &mysql.MySQLError{Number: 1213}
+			}
+
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("stop retrying when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		var attempts int
+		err := dbClient.WithTransaction(ctx, func(tx *persistence.DBClient) error {
+			attempts++
+			cancel()
+			return &mysql.MySQLError{Number: 1213}
+		})
+
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.True(t, errors.Is(err, exception.ErrCanceled))
+		assert.Equal(t, 1, attempts)
+	})
+}
+
+func TestDBClientWithTransactionNested(t *testing.T) {
+	ctx := context.Background()
+	dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+
+	outer := f.DocumentCategory().Build()
+	inner := f.DocumentCategory().Build()
+	errInner := &mysql.MySQLError{Number: 1213}
+
+	var innerAttempts int
+	err := dbClient.WithTransaction(ctx, func(tx *persistence.DBClient) error {
+		_, err := tx.DocumentCategory.SaveDocumentCategory(ctx, outer)
+		require.NoError(t, err)
+
+		// The nested call is rolled back to its savepoint and is not retried, the outer transaction goes on.
+		err = tx.WithTransaction(ctx, func(tx *persistence.DBClient) error {
+			innerAttempts++
+			_, err := tx.DocumentCategory.SaveDocumentCategory(ctx, inner)
+			require.NoError(t, err)
+
+			return errInner
+		})
+		assert.True(t, errors.Is(err, errInner))
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, innerAttempts)
+
+	_, err = dbClient.DocumentCategory.FindDocumentCategory(ctx, outer)
+	assert.NoError(t, err)
+
+	_, err = dbClient.DocumentCategory.FindDocumentCategory(ctx, &entity.DocumentCategory{ID: inner.ID})
+	assert.True(t, errors.Is(err, exception.ErrNotFound))
+}
@@ -0,0 +1,33 @@
+package persistence
+
+import (
+	"micro/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+// checkVersion return repository.ErrVersionConflict when the expected version is set and it is not the current one.
+func checkVersion(current int64, expected int64) error {
+	if expected > 0 && expected != current {
+		return repository.ErrVersionConflict
+	}
+
+	return nil
+}
+
+// updateVersion will update the row with the id by the value only when the row still has the version which is read
+// before, so the concurrent update of the same row is reported as repository.ErrVersionConflict instead of being
+// overwritten. The value is a struct whose non-zero fields are updated, or a map whose every column is updated
+// including the zero values. The version of the value must be the next version.
+func updateVersion(tx *gorm.DB, model interface{}, id string, version int64, value interface{}) error {
+	result := tx.Model(model).Where("id = ? AND version = ?", id, version).Updates(value)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return repository.ErrVersionConflict
+	}
+
+	return nil
+}
@@ -1,5 +1,7 @@
 package configurator
 
+import "time"
+
 // Config represent config keys.
 type Config struct {
 	AppName        string
@@ -11,7 +13,8 @@ type Config struct {
 	AppGRPCPort string
 
 	DBConfig
-	DBReplicaTotal int
+	DBReplicaTotal               int
+	DBReplicaHealthCheckInterval time.Duration
 	DBReplicasConfig
 	DBTestConfig
 
@@ -27,6 +30,8 @@ type Config struct {
 
 	DataDogConfig
 
+	SchedulerConfig
+
 	DebugMode              bool
 	TestMode               bool
 	MaxIdleCons            int
@@ -45,6 +50,7 @@ type DBConfig struct {
 	DBTimeZone                  string
 	DBLog                       bool
 	DisableForeignKeyConstraint bool
+	AllowPendingMigration       bool
 }
 
 // GoogleCloudServiceConfig represent google cloud config keys.
@@ -106,6 +112,12 @@ type DataDogConfig struct {
 	APIKey       string
 }
 
+// SchedulerConfig represent scheduler jobs config keys.
+type SchedulerConfig struct {
+	RetentionPurgeInterval  time.Duration
+	RetentionPurgeBatchSize int
+}
+
 // DBReplicaConfig represent db config keys.
 type DBReplicaConfig struct {
 	DBDriver                    string
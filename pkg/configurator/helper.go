@@ -4,6 +4,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // GetEnv is a function uses to read an environment or return a default value.
@@ -39,6 +40,16 @@ func GetEnvAsBool(name string, defaultVal bool) bool {
 	return defaultVal
 }
 
+// GetEnvAsDuration is a function uses to read an environment variable into a time.Duration or return a default value.
+func GetEnvAsDuration(name string, defaultVal time.Duration) time.Duration {
+	valStr := GetEnv(name, "")
+	if val, err := time.ParseDuration(valStr); err == nil {
+		return val
+	}
+
+	return defaultVal
+}
+
 // GetEnvAsSliceOfString is a function to read an environment variable into a string slice or return default value.
 func GetEnvAsSliceOfString(name string, defaultVal []string, sep string) []string {
 	valStr := GetEnv(name, "")
@@ -1,5 +1,10 @@
 package configurator
 
+import (
+	"fmt"
+	"time"
+)
+
 // Option return config with option.
 type Option func(config *Config)
 
@@ -16,6 +21,58 @@ func WithDBConfig() Option {
 			DBTimeZone:                  GetEnv("APP_TIMEZONE", "Asia/Jakarta"),
 			DBLog:                       GetEnvAsBool("ENABLE_LOGGER", true),
 			DisableForeignKeyConstraint: GetEnvAsBool("DISABLE_FOREIGN_KEY_CONSTRAINT", false),
+			AllowPendingMigration:       GetEnvAsBool("DB_ALLOW_PENDING_MIGRATION", false),
+		}
+	}
+}
+
+// WithDBTestConfig is a function uses to set DBTestConfig to the Config.
+// The test database is used instead of the primary db when the Config is on the test mode.
+func WithDBTestConfig() Option {
+	return func(config *Config) {
+		config.DBTestConfig = DBTestConfig{
+			DBDriver:                    GetEnv("DB_TEST_DRIVER", "mysql"),
+			DBHost:                      GetEnv("DB_TEST_HOST", "localhost"),
+			DBPort:                      GetEnv("DB_TEST_PORT", "3306"),
+			DBUser:                      GetEnv("DB_TEST_USER", "root"),
+			DBName:                      GetEnv("DB_TEST_NAME", "go_user_test"),
+			DBPassword:                  GetEnv("DB_TEST_PASSWORD", ""),
+			DBTimeZone:                  GetEnv("APP_TIMEZONE", "Asia/Jakarta"),
+			DBLog:                       GetEnvAsBool("ENABLE_LOGGER", true),
+			DisableForeignKeyConstraint: GetEnvAsBool("DISABLE_FOREIGN_KEY_CONSTRAINT", false),
+		}
+	}
+}
+
+// WithTestMode is a function uses to set the Config on the test mode, so the test database is used.
+func WithTestMode() Option {
+	return func(config *Config) {
+		config.TestMode = true
+	}
+}
+
+// WithDBReplicasConfig is a function uses to set DBReplicasConfig to the Config.
+// The replicas are read from DB_REPLICA_1_HOST, DB_REPLICA_2_HOST, etc. up to DB_REPLICA_TOTAL,
+// the key which is not set falls back to the primary db config, so WithDBConfig must be given first.
+func WithDBReplicasConfig() Option {
+	return func(config *Config) {
+		config.DBReplicaTotal = GetEnvAsInt("DB_REPLICA_TOTAL", 0)
+		config.DBReplicaHealthCheckInterval = GetEnvAsDuration("DB_REPLICA_HEALTH_CHECK_INTERVAL", 10*time.Second)
+		config.DBReplicasConfig = nil
+
+		for i := 1; i <= config.DBReplicaTotal; i++ {
+			prefix := fmt.Sprintf("DB_REPLICA_%d_", i)
+			config.DBReplicasConfig = append(config.DBReplicasConfig, &DBReplicaConfig{
+				DBDriver:                    GetEnv(prefix+"DRIVER", config.DBConfig.DBDriver),
+				DBHost:                      GetEnv(prefix+"HOST", config.DBConfig.DBHost),
+				DBPort:                      GetEnv(prefix+"PORT", config.DBConfig.DBPort),
+				DBUser:                      GetEnv(prefix+"USER", config.DBConfig.DBUser),
+				DBName:                      GetEnv(prefix+"NAME", config.DBConfig.DBName),
+				DBPassword:                  GetEnv(prefix+"PASSWORD", config.DBConfig.DBPassword),
+				DBTimeZone:                  config.DBConfig.DBTimeZone,
+				DBLog:                       config.DBConfig.DBLog,
+				DisableForeignKeyConstraint: config.DBConfig.DisableForeignKeyConstraint,
+			})
 		}
 	}
 }
@@ -94,3 +151,13 @@ func WithStorageConfig() Option {
 		}
 	}
 }
+
+// WithSchedulerConfig is a function uses to set SchedulerConfig to the Config.
+func WithSchedulerConfig() Option {
+	return func(config *Config) {
+		config.SchedulerConfig = SchedulerConfig{
+			RetentionPurgeInterval:  GetEnvAsDuration("RETENTION_PURGE_INTERVAL", time.Hour),
+			RetentionPurgeBatchSize: GetEnvAsInt("RETENTION_PURGE_BATCH_SIZE", 100),
+		}
+	}
+}
@@ -0,0 +1,447 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// The kinds of the Difference between the entity and the database.
+const (
+	DiffMissingTable      = "missing_table"
+	DiffMissingColumn     = "missing_column"
+	DiffExtraColumn       = "extra_column"
+	DiffMismatchedColumn  = "mismatched_column"
+	DiffMissingIndex      = "missing_index"
+	DiffExtraIndex        = "extra_index"
+	driverPostgres        = "postgres"
+	driverMysql           = "mysql"
+	postgresPrimarySuffix = "_pkey"
+	mysqlPrimaryIndex     = "PRIMARY"
+)
+
+// ErrUnsupportedDiffDriver is returned when the schema diff is run on the driver other than postgres and mysql.
+var ErrUnsupportedDiffDriver = errors.New("common.error.database.diff.driver_not_supported")
+
+// Difference is a struct uses to holds a drift between the schema of the entity and the live database.
+// Expected is taken from the entity and Actual is taken from the database.
+type Difference struct {
+	Table    string
+	Kind     string
+	Name     string
+	Expected string
+	Actual   string
+
+	model interface{}
+}
+
+// column holds the column of the live database.
+type column struct {
+	dataType string
+	nullable bool
+}
+
+// reTypeSize match the size, the precision, and the modifier of the data type, e.g. (255) or unsigned.
+var reTypeSize = regexp.MustCompile(`\(.*\)| unsigned`)
+
+// reSize match the size of the data type, e.g. (255).
+var reSize = regexp.MustCompile(`\(\d+\)`)
+
+// dataTypeAliases map the data type reported by the database or gorm to a comparable data type.
+var dataTypeAliases = map[string]string{
+	"character varying":           "varchar",
+	"character":                   "char",
+	"timestamp with time zone":    "timestamptz",
+	"timestamp without time zone": "timestamp",
+	"int":                         "integer",
+	"int4":                        "integer",
+	"serial":                      "integer",
+	"int8":                        "bigint",
+	"bigserial":                   "bigint",
+	"int2":                        "smallint",
+	"smallserial":                 "smallint",
+	"bool":                        "boolean",
+	"tinyint":                     "boolean",
+	"decimal":                     "numeric",
+	"double precision":            "double",
+	"float8":                      "double",
+}
+
+// Diff is a function uses to compare the schema of the models parsed by gorm with the live database.
+// The columns are read from information_schema, the indexes are read from pg_indexes on postgres and
+// information_schema.statistics on mysql.
+func Diff(db *gorm.DB, models []interface{}) ([]Difference, error) {
+	driver := db.Dialector.Name()
+	if driver != driverPostgres && driver != driverMysql {
+		return nil, ErrUnsupportedDiffDriver
+	}
+
+	var diffs []Difference
+	for _, model := range models {
+		model = toPointer(model)
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return nil, err
+		}
+
+		columns, err := liveColumns(db, stmt.Table)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(columns) == 0 {
+			diffs = append(diffs, Difference{Table: stmt.Table, Kind: DiffMissingTable, Name: stmt.Table, model: model})
+			continue
+		}
+
+		diffs = append(diffs, diffColumns(db, stmt, columns, model)...)
+
+		indexes, err := liveIndexes(db, stmt.Table)
+		if err != nil {
+			return nil, err
+		}
+
+		diffs = append(diffs, diffIndexes(db, stmt, indexes, model)...)
+	}
+
+	return diffs, nil
+}
+
+// diffColumns compare the fields of the schema with the columns of the live database.
+func diffColumns(db *gorm.DB, stmt *gorm.Statement, columns map[string]column, model interface{}) []Difference {
+	var diffs []Difference
+	for _, dbName := range stmt.Schema.DBNames {
+		field := stmt.Schema.LookUpField(dbName)
+		if field == nil || field.IgnoreMigration {
+			continue
+		}
+
+		expected := column{
+			dataType: db.Dialector.DataTypeOf(field),
+			nullable: !field.NotNull && !field.PrimaryKey,
+		}
+
+		actual, ok := columns[dbName]
+		if !ok {
+			diffs = append(diffs, Difference{Table: stmt.Table, Kind: DiffMissingColumn, Name: dbName, Expected: expected.String(), model: model})
+			continue
+		}
+
+		if !sameDataType(expected.dataType, actual.dataType) || expected.nullable != actual.nullable {
+			diffs = append(diffs, Difference{Table: stmt.Table, Kind: DiffMismatchedColumn, Name: dbName, Expected: expected.String(), Actual: actual.String(), model: model})
+		}
+	}
+
+	for _, name := range sortedKeys(columns) {
+		if _, ok := stmt.Schema.FieldsByDBName[name]; !ok {
+			diffs = append(diffs, Difference{Table: stmt.Table, Kind: DiffExtraColumn, Name: name, Actual: columns[name].String(), model: model})
+		}
+	}
+
+	return diffs
+}
+
+// ExtraIndexesInterface is implemented by the entity which creates the indexes the gorm index tag can not describe,
+// e.g. a partial index. Diff does not report them as extra indexes.
+type ExtraIndexesInterface interface {
+	ExtraIndexes() []string
+}
+
+// diffIndexes compare the indexes of the schema with the indexes of the live database.
+// The primary key, the unique constraint of the field and the extra indexes of the model are not compared.
+func diffIndexes(db *gorm.DB, stmt *gorm.Statement, indexes map[string]bool, model interface{}) []Difference {
+	var diffs []Difference
+	expected := stmt.Schema.ParseIndexes()
+	for _, name := range sortedKeys(expected) {
+		if !indexes[name] {
+			diffs = append(diffs, Difference{Table: stmt.Table, Kind: DiffMissingIndex, Name: name, Expected: indexFields(expected[name]), model: model})
+		}
+	}
+
+	ignored := map[string]bool{mysqlPrimaryIndex: true, stmt.Table + postgresPrimarySuffix: true}
+	for _, field := range stmt.Schema.Fields {
+		if field.Unique {
+			ignored[field.DBName] = true
+			ignored[stmt.Table+"_"+field.DBName+"_key"] = true
+			ignored[db.NamingStrategy.UniqueName(stmt.Table, field.DBName)] = true
+		}
+	}
+
+	if extra, ok := model.(ExtraIndexesInterface); ok {
+		for _, name := range extra.ExtraIndexes() {
+			ignored[name] = true
+		}
+	}
+
+	for _, name := range sortedKeys(indexes) {
+		if _, ok := expected[name]; !ok && !ignored[name] {
+			diffs = append(diffs, Difference{Table: stmt.Table, Kind: DiffExtraIndex, Name: name, model: model})
+		}
+	}
+
+	return diffs
+}
+
+// FixSQL is a function uses to build the up and down statements which fix the differences.
+// The statements are built by gorm migrator in dry run, so they follow the dialect of db.
+// The extra columns and the extra indexes are dropped by the commented statements, they must be reviewed first.
+func FixSQL(db *gorm.DB, diffs []Difference) (string, string, error) {
+	var up, down strings.Builder
+	var downs [][]string
+	for _, diff := range diffs {
+		var upStatements, downStatements []string
+		var err error
+
+		switch diff.Kind {
+		case DiffMissingTable:
+			upStatements, err = dryRun(db, func(tx *gorm.DB) error { return tx.Migrator().CreateTable(diff.model) })
+			downStatements, _ = dryRun(db, func(tx *gorm.DB) error { return tx.Migrator().DropTable(diff.model) })
+		case DiffMissingColumn:
+			upStatements, err = dryRun(db, func(tx *gorm.DB) error { return tx.Migrator().AddColumn(diff.model, diff.Name) })
+			downStatements, _ = dryRun(db, func(tx *gorm.DB) error { return tx.Migrator().DropColumn(diff.model, diff.Name) })
+		case DiffMismatchedColumn:
+			upStatements, err = alterColumn(db, diff)
+			downStatements = []string{fmt.Sprintf("-- revert %s.%s to %s manually", diff.Table, diff.Name, diff.Actual)}
+		case DiffMissingIndex:
+			upStatements, err = dryRun(db, func(tx *gorm.DB) error { return tx.Migrator().CreateIndex(diff.model, diff.Name) })
+			downStatements, _ = dryRun(db, func(tx *gorm.DB) error { return tx.Migrator().DropIndex(diff.model, diff.Name) })
+		case DiffExtraColumn:
+			upStatements, err = dryRun(db, func(tx *gorm.DB) error { return tx.Migrator().DropColumn(diff.model, diff.Name) })
+			upStatements = commentOut(upStatements)
+		case DiffExtraIndex:
+			upStatements, err = dryRun(db, func(tx *gorm.DB) error { return tx.Migrator().DropIndex(diff.model, diff.Name) })
+			upStatements = commentOut(upStatements)
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("%s %s.%s: %w", diff.Kind, diff.Table, diff.Name, err)
+		}
+
+		writeStatements(&up, upStatements)
+		downs = append(downs, downStatements)
+	}
+
+	// The down migration undo the last fix first.
+	for i := len(downs) - 1; i >= 0; i-- {
+		writeStatements(&down, downs[i])
+	}
+
+	return up.String(), down.String(), nil
+}
+
+// alterColumn return the statements which alter the type and the nullability of the column to the field.
+// The postgres migrator inspects the live column to alter it, which is not possible in dry run, so the
+// statements are built here on postgres.
+func alterColumn(db *gorm.DB, diff Difference) ([]string, error) {
+	if db.Dialector.Name() != driverPostgres {
+		return dryRun(db, func(tx *gorm.DB) error { return tx.Migrator().AlterColumn(diff.model, diff.Name) })
+	}
+
+	return dryRun(db, func(tx *gorm.DB) error {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(diff.model); err != nil {
+			return err
+		}
+
+		field := stmt.Schema.LookUpField(diff.Name)
+		if field == nil {
+			return fmt.Errorf("failed to look up field with name: %s", diff.Name)
+		}
+
+		table, column := clause.Table{Name: stmt.Table}, clause.Column{Name: field.DBName}
+		nullability := "DROP NOT NULL"
+		if field.NotNull || field.PrimaryKey {
+			nullability = "SET NOT NULL"
+		}
+
+		if err := tx.Exec("ALTER TABLE ? ALTER COLUMN ? TYPE ?", table, column, clause.Expr{SQL: db.Dialector.DataTypeOf(field)}).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec("ALTER TABLE ? ALTER COLUMN ? "+nullability, table, column).Error
+	})
+}
+
+// String return the data type and the nullability of the column.
+func (c column) String() string {
+	if c.nullable {
+		return c.dataType + " NULL"
+	}
+
+	return c.dataType + " NOT NULL"
+}
+
+// liveColumns return the columns of the table by the name, it is empty when the table does not exist.
+func liveColumns(db *gorm.DB, table string) (map[string]column, error) {
+	query := "SELECT column_name, CASE WHEN character_maximum_length IS NULL THEN data_type ELSE data_type || '(' || character_maximum_length || ')' END, is_nullable " +
+		"FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = ?"
+	if db.Dialector.Name() == driverMysql {
+		query = "SELECT column_name, column_type, is_nullable FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = ?"
+	}
+
+	rows, err := db.Raw(query, table).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make(map[string]column)
+	for rows.Next() {
+		var name, dataType, nullable string
+		if err = rows.Scan(&name, &dataType, &nullable); err != nil {
+			return nil, err
+		}
+
+		columns[name] = column{dataType: dataType, nullable: strings.EqualFold(nullable, "YES")}
+	}
+
+	return columns, rows.Err()
+}
+
+// liveIndexes return the name of the indexes of the table.
+func liveIndexes(db *gorm.DB, table string) (map[string]bool, error) {
+	query := "SELECT indexname FROM pg_indexes WHERE schemaname = current_schema() AND tablename = ?"
+	if db.Dialector.Name() == driverMysql {
+		query = "SELECT DISTINCT index_name FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ?"
+	}
+
+	var names []string
+	err := db.Raw(query, table).Scan(&names).Error
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make(map[string]bool, len(names))
+	for _, name := range names {
+		indexes[name] = true
+	}
+
+	return indexes, nil
+}
+
+// sameDataType return true when the data types are the same, the size is only compared on the character types
+// because the size of the other types is reported differently by each database.
+func sameDataType(expected string, actual string) bool {
+	base := normalizeDataType(expected)
+	if base != normalizeDataType(actual) {
+		return false
+	}
+
+	if base == "varchar" || base == "char" {
+		return reSize.FindString(expected) == reSize.FindString(actual)
+	}
+
+	return true
+}
+
+// normalizeDataType return the comparable data type, the size and the modifier of the data type are removed.
+func normalizeDataType(dataType string) string {
+	dataType = strings.TrimSpace(reTypeSize.ReplaceAllString(strings.ToLower(dataType), ""))
+	if alias, ok := dataTypeAliases[dataType]; ok {
+		return alias
+	}
+
+	return dataType
+}
+
+// indexFields return the columns of the index separated by comma.
+func indexFields(index schema.Index) string {
+	var fields []string
+	for _, field := range index.Fields {
+		if field.Field != nil {
+			fields = append(fields, field.DBName)
+		} else {
+			fields = append(fields, field.Expression)
+		}
+	}
+
+	return strings.Join(fields, ",")
+}
+
+// toPointer return the pointer of the model, the entities are registered as value.
+func toPointer(model interface{}) interface{} {
+	if reflect.TypeOf(model).Kind() == reflect.Ptr {
+		return model
+	}
+
+	value := reflect.New(reflect.TypeOf(model))
+	value.Elem().Set(reflect.ValueOf(model))
+
+	return value.Interface()
+}
+
+// sortedKeys return the keys of the map in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// statementRecorder is a gorm logger which records the statements instead of logging them.
+type statementRecorder struct {
+	statements []string
+}
+
+// LogMode return the recorder itself, the level is not used.
+func (r *statementRecorder) LogMode(logger.LogLevel) logger.Interface { return r }
+
+// Info is not used by the recorder.
+func (r *statementRecorder) Info(context.Context, string, ...interface{}) {}
+
+// Warn is not used by the recorder.
+func (r *statementRecorder) Warn(context.Context, string, ...interface{}) {}
+
+// Error is not used by the recorder.
+func (r *statementRecorder) Error(context.Context, string, ...interface{}) {}
+
+// Trace record the statement except the query which is run by the migrator to inspect the database.
+func (r *statementRecorder) Trace(_ context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	statement, _ := fc()
+	if !strings.HasPrefix(strings.ToUpper(strings.TrimSpace(statement)), "SELECT") {
+		r.statements = append(r.statements, statement)
+	}
+}
+
+// dryRun return the statements of fn which are built on the dry run session without being executed.
+func dryRun(db *gorm.DB, fn func(tx *gorm.DB) error) ([]string, error) {
+	recorder := &statementRecorder{}
+	err := fn(db.Session(&gorm.Session{DryRun: true, Logger: recorder}))
+
+	return recorder.statements, err
+}
+
+// commentOut comment out the statements.
+func commentOut(statements []string) []string {
+	commented := make([]string, 0, len(statements))
+	for _, statement := range statements {
+		commented = append(commented, "-- review: "+strings.ReplaceAll(statement, "\n", "\n-- "))
+	}
+
+	return commented
+}
+
+// writeStatements write the statements to b, each statement is ended by a semicolon at the end of the line.
+func writeStatements(b *strings.Builder, statements []string) {
+	for _, statement := range statements {
+		statement = strings.TrimSpace(statement)
+		if !strings.HasPrefix(statement, "--") && !strings.HasSuffix(statement, ";") {
+			statement += ";"
+		}
+
+		b.WriteString(statement)
+		b.WriteString("\n")
+	}
+}
@@ -0,0 +1,111 @@
+package migration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeDataType(t *testing.T) {
+	testCases := []struct {
+		dataType string
+		expected string
+	}{
+		{dataType: "varchar(255)", expected: "varchar"},
+		{dataType: "character varying(100)", expected: "varchar"},
+		{dataType: "CHARACTER(36)", expected: "char"},
+		{dataType: "int(11) unsigned", expected: "integer"},
+		{dataType: "int8", expected: "bigint"},
+		{dataType: "bigserial", expected: "bigint"},
+		{dataType: "tinyint(1)", expected: "boolean"},
+		{dataType: "decimal(10,2)", expected: "numeric"},
+		{dataType: "double precision", expected: "double"},
+		{dataType: "timestamp with time zone", expected: "timestamptz"},
+		{dataType: " text ", expected: "text"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.dataType, func(t *testing.T) {
+			assert.Equal(t, tc.expected, normalizeDataType(tc.dataType))
+		})
+	}
+}
+
+func TestSameDataType(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+		actual   string
+		same     bool
+	}{
+		{name: "same varchar size", expected: "varchar(36)", actual: "character varying(36)", same: true},
+		{name: "different varchar size", expected: "varchar(36)", actual: "varchar(100)", same: false},
+		{name: "different char size", expected: "char(2)", actual: "character(3)", same: false},
+		{name: "integer size is ignored", expected: "bigint", actual: "bigint(20)", same: true},
+		{name: "alias of integer", expected: "int", actual: "int4", same: true},
+		{name: "alias of boolean", expected: "boolean", actual: "tinyint(1)", same: true},
+		{name: "different type", expected: "bigint", actual: "double precision", same: false},
+		{name: "varchar and text", expected: "varchar(255)", actual: "text", same: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.same, sameDataType(tc.expected, tc.actual))
+		})
+	}
+}
+
+func TestCommentOut(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statements []string
+		expected   []string
+	}{
+		{name: "no statement", statements: nil, expected: []string{}},
+		{
+			name:       "single line",
+			statements: []string{"ALTER TABLE a DROP COLUMN b"},
+			expected:   []string{"-- review: ALTER TABLE a DROP COLUMN b"},
+		},
+		{
+			name:       "multiple lines",
+			statements: []string{"DROP INDEX idx\nON a", "DROP INDEX idy"},
+			expected:   []string{"-- review: DROP INDEX idx\n-- ON a", "-- review: DROP INDEX idy"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, commentOut(tc.statements))
+		})
+	}
+}
+
+func TestWriteStatements(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statements []string
+		expected   string
+	}{
+		{name: "no statement", statements: nil, expected: ""},
+		{
+			name:       "add the missing semicolon",
+			statements: []string{"ALTER TABLE a ADD b int", " CREATE INDEX idx ON a (b); "},
+			expected:   "ALTER TABLE a ADD b int;\nCREATE INDEX idx ON a (b);\n",
+		},
+		{
+			name:       "keep the comment as is",
+			statements: []string{"-- review: ALTER TABLE a DROP COLUMN b", "-- revert a.b to int manually"},
+			expected:   "-- review: ALTER TABLE a DROP COLUMN b\n-- revert a.b to int manually\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var b strings.Builder
+			writeStatements(&b, tc.statements)
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// ErrLockTimeout is returned when the migration lock is not acquired before the lockTimeout.
+var ErrLockTimeout = errors.New("common.error.database.migration_lock_timeout")
+
+const (
+	// lockName is the name of the MySQL lock, lockKey is the key of the Postgres advisory lock.
+	lockName = "micro_schema_migrations"
+	lockKey  = 7236852467366425454
+
+	// lockTimeout is the duration the migrator waits for the other migrator to release the lock.
+	lockTimeout = 10 * time.Minute
+)
+
+// primary return the db whose reads are sent to the primary database, so the applied migrations are never read
+// from a lagging replica.
+func primary(db *gorm.DB) *gorm.DB {
+	return db.Clauses(dbresolver.Write).Session(&gorm.Session{})
+}
+
+// lock will acquire the migration lock, so only one migrator applies or reverts the migrations at a time.
+// The lock is a session lock, so it is held by a dedicated connection until the returned unlock is called.
+// SQLite locks the database file on write, so it is not locked.
+func lock(db *gorm.DB) (func() error, error) {
+	if db.Dialector.Name() != "postgres" && db.Dialector.Name() != "mysql" {
+		return func() error { return nil }, nil
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lockTimeout)
+	defer cancel()
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if db.Dialector.Name() == "postgres" {
+		return lockPostgres(ctx, conn)
+	}
+
+	return lockMysql(ctx, conn)
+}
+
+// lockPostgres will acquire the advisory lock of Postgres on the conn.
+func lockPostgres(ctx context.Context, conn *sql.Conn) (func() error, error) {
+	_, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey)
+	if err != nil {
+		_ = conn.Close()
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, ErrLockTimeout
+		}
+
+		return nil, err
+	}
+
+	return func() error {
+		defer conn.Close()
+
+		_, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey)
+		return err
+	}, nil
+}
+
+// lockMysql will acquire the named lock of MySQL on the conn, GET_LOCK return 1 when the lock is acquired.
+func lockMysql(ctx context.Context, conn *sql.Conn) (func() error, error) {
+	var acquired sql.NullInt64
+	err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, int(lockTimeout.Seconds())).Scan(&acquired)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	if acquired.Int64 != 1 {
+		_ = conn.Close()
+		return nil, ErrLockTimeout
+	}
+
+	return func() error {
+		defer conn.Close()
+
+		_, err := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName)
+		return err
+	}, nil
+}
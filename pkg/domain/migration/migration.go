@@ -0,0 +1,225 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrPendingMigration is returned when the database has a migration which is not applied yet.
+var ErrPendingMigration = errors.New("common.error.database.pending_migration")
+
+// Migration is a struct uses to holds the version, the name, and the Up and Down functions of a migration.
+// The version is the UTC timestamp the migration is created at, e.g. 20230101120000, the migrations run in
+// the order of the version.
+type Migration struct {
+	Version string
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error
+}
+
+// SchemaMigration is the row of schema_migrations table which records the applied migration.
+type SchemaMigration struct {
+	Version   string `gorm:"primaryKey;size:14"`
+	Name      string `gorm:"size:255;not null"`
+	AppliedAt time.Time
+}
+
+// TableName return the table name of the applied migrations.
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Status is a struct uses to holds the migration and when it is applied, AppliedAt is nil when it is pending.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrator is a struct uses to holds the collection of Migration sorted by the version.
+type Migrator struct {
+	Migrations []Migration
+}
+
+// New is a constructor will initialize Migrator.
+func New(migrations []Migration) *Migrator {
+	sorted := append([]Migration{}, migrations...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+
+	return &Migrator{Migrations: sorted}
+}
+
+// Migrate is a function uses to apply the pending migrations in the order of the version.
+// Each migration is applied in its own transaction and the applied migrations are returned.
+// MySQL commits the DDL statements implicitly, so a failed migration on MySQL may be applied partially.
+// The migrations are applied on the primary database while the migration lock is held, so the concurrent migrators
+// wait for each other and never apply the same migration twice.
+func (m *Migrator) Migrate(db *gorm.DB) (applied []Migration, err error) {
+	db = primary(db)
+	unlock, err := lock(db)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if errUnlock := unlock(); err == nil {
+			err = errUnlock
+		}
+	}()
+
+	pending, err := m.Pending(db)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, migration := range pending {
+		migration := migration
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if migration.Up != nil {
+				if errUp := migration.Up(tx); errUp != nil {
+					return errUp
+				}
+			}
+
+			return tx.Create(&SchemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("migration %s_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		applied = append(applied, migration)
+	}
+
+	return applied, nil
+}
+
+// Rollback is a function uses to revert the last steps of the applied migrations in the reverse order of the version.
+// The rolled back migrations are returned, the migrations are reverted on the primary database while the migration
+// lock is held.
+func (m *Migrator) Rollback(db *gorm.DB, steps int) (rolledBack []Migration, err error) {
+	db = primary(db)
+	unlock, err := lock(db)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if errUnlock := unlock(); err == nil {
+			err = errUnlock
+		}
+	}()
+
+	statuses, err := m.Status(db)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(statuses) - 1; i >= 0 && len(rolledBack) < steps; i-- {
+		if statuses[i].AppliedAt == nil {
+			continue
+		}
+
+		migration := statuses[i].Migration
+		if migration.Down == nil {
+			return rolledBack, fmt.Errorf("migration %s_%s: down is not defined", migration.Version, migration.Name)
+		}
+
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if errDown := migration.Down(tx); errDown != nil {
+				return errDown
+			}
+
+			return tx.Delete(&SchemaMigration{Version: migration.Version}).Error
+		})
+		if err != nil {
+			return rolledBack, fmt.Errorf("migration %s_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		rolledBack = append(rolledBack, migration)
+	}
+
+	return rolledBack, nil
+}
+
+// Status is a function uses to get the status of each migration in the order of the version.
+func (m *Migrator) Status(db *gorm.DB) ([]Status, error) {
+	applied, err := m.applied(db)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.Migrations))
+	for _, migration := range m.Migrations {
+		status := Status{Migration: migration}
+		if schemaMigration, ok := applied[migration.Version]; ok {
+			appliedAt := schemaMigration.AppliedAt
+			status.AppliedAt = &appliedAt
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// Pending is a function uses to get the migrations which are not applied yet.
+func (m *Migrator) Pending(db *gorm.DB) ([]Migration, error) {
+	statuses, err := m.Status(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			pending = append(pending, status.Migration)
+		}
+	}
+
+	return pending, nil
+}
+
+// CheckPending return ErrPendingMigration when there is a migration which is not applied yet.
+func (m *Migrator) CheckPending(db *gorm.DB) error {
+	pending, err := m.Pending(db)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d migration(s), the first is %s_%s", ErrPendingMigration, len(pending), pending[0].Version, pending[0].Name)
+	}
+
+	return nil
+}
+
+// applied return the applied migrations by the version, schema_migrations table is created when it does not exist.
+// The applied migrations are read from the primary database, a lagging replica may miss the last applied ones.
+func (m *Migrator) applied(db *gorm.DB) (map[string]SchemaMigration, error) {
+	db = primary(db)
+	err := db.AutoMigrate(&SchemaMigration{})
+	if err != nil {
+		return nil, err
+	}
+
+	var schemaMigrations []SchemaMigration
+	err = db.Order("version").Find(&schemaMigrations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[string]SchemaMigration, len(schemaMigrations))
+	for _, schemaMigration := range schemaMigrations {
+		applied[schemaMigration.Version] = schemaMigration
+	}
+
+	return applied, nil
+}
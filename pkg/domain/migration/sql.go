@@ -0,0 +1,182 @@
+package migration
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// VersionLayout is the layout of the time uses as the version of the migration.
+const VersionLayout = "20060102150405"
+
+// reSQLFile match the file name of the SQL migration, e.g. 20230101120000_create_documents.up.sql, or
+// 20230101120000_create_documents.up.postgres.sql which is used instead of the generic file on the driver.
+var reSQLFile = regexp.MustCompile(`^(\d{14})_([a-z0-9_]+)\.(up|down)(?:\.([a-z]+))?\.sql$`)
+
+// reMigrationName match the name of the migration.
+var reMigrationName = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// sqlMigration holds the up and down statements of the SQL migration by the driver, the generic file is keyed by "".
+type sqlMigration struct {
+	version string
+	name    string
+	up      map[string]string
+	down    map[string]string
+}
+
+// LoadSQL is a function uses to load the SQL migrations from the root directory of fsys.
+// The statements of a file are separated by a semicolon at the end of the line.
+func LoadSQL(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	sqlMigrations := make(map[string]*sqlMigration)
+	var versions []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		matches := reSQLFile.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("migration file %s: invalid file name", entry.Name())
+		}
+
+		content, errRead := fs.ReadFile(fsys, entry.Name())
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		version, name, direction, driver := matches[1], matches[2], matches[3], matches[4]
+		migration, ok := sqlMigrations[version]
+		if !ok {
+			migration = &sqlMigration{version: version, name: name, up: map[string]string{}, down: map[string]string{}}
+			sqlMigrations[version] = migration
+			versions = append(versions, version)
+		}
+
+		if migration.name != name {
+			return nil, fmt.Errorf("migration file %s: version %s is used by %s", entry.Name(), version, migration.name)
+		}
+
+		if direction == "up" {
+			migration.up[driver] = string(content)
+		} else {
+			migration.down[driver] = string(content)
+		}
+	}
+
+	var migrations []Migration
+	for _, version := range versions {
+		migration := sqlMigrations[version]
+		migrations = append(migrations, Migration{
+			Version: migration.version,
+			Name:    migration.name,
+			Up:      execSQL(migration.up),
+			Down:    execSQL(migration.down),
+		})
+	}
+
+	return migrations, nil
+}
+
+// execSQL return the function which execute the statements of the driver of tx, or the generic statements when
+// there is no statement for the driver. It returns nil when there is no statement at all.
+func execSQL(statements map[string]string) func(tx *gorm.DB) error {
+	if len(statements) == 0 {
+		return nil
+	}
+
+	return func(tx *gorm.DB) error {
+		content, ok := statements[tx.Dialector.Name()]
+		if !ok {
+			content, ok = statements[""]
+		}
+		if !ok {
+			return fmt.Errorf("no statement for driver %s", tx.Dialector.Name())
+		}
+
+		for _, statement := range splitStatements(content) {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// splitStatements split the content by the semicolon at the end of the line and remove the empty statements.
+func splitStatements(content string) []string {
+	var statements []string
+	var statement strings.Builder
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		statement.WriteString(line)
+		statement.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSpace(statement.String()))
+			statement.Reset()
+		}
+	}
+
+	if rest := strings.TrimSpace(statement.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+
+	return statements
+}
+
+// CreateSQL is a function uses to create the empty up and down SQL migration files on the dir.
+// The version is taken from now and the paths of the created files are returned.
+func CreateSQL(dir string, name string, now time.Time) ([]string, error) {
+	return WriteSQL(dir, name, "", now, "", "")
+}
+
+// WriteSQL is a function uses to write the up and down statements as the SQL migration files on the dir.
+// The files of the driver, e.g. .up.postgres.sql, are written when the statements follow the dialect of the driver,
+// the generic files are written when the driver is empty.
+// The version is taken from now and the paths of the written files are returned.
+func WriteSQL(dir string, name string, driver string, now time.Time, up string, down string) ([]string, error) {
+	if !reMigrationName.MatchString(name) {
+		return nil, fmt.Errorf("migration name %s: must be lowercase letters, digits or underscore", name)
+	}
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	version := now.UTC().Format(VersionLayout)
+	var paths []string
+	for _, file := range []struct{ direction, statements string }{{"up", up}, {"down", down}} {
+		direction := file.direction
+		if driver != "" {
+			direction += "." + driver
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("%s_%s.%s.sql", version, name, direction))
+		content := fmt.Sprintf("-- %s migration of %s_%s.\n%s", file.direction, version, name, file.statements)
+
+		err = os.WriteFile(path, []byte(content), 0644)
+		if err != nil {
+			return paths, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,81 @@
+package migration_test
+
+import (
+	"micro/pkg/domain/migration"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrationLoadSQL(t *testing.T) {
+	migrations, err := migration.LoadSQL(fstest.MapFS{
+		"20230102000000_add_index.up.sql":         {Data: []byte("CREATE INDEX idx ON documents (name);")},
+		"20230102000000_add_index.down.sql":       {Data: []byte("DROP INDEX idx;")},
+		"20230102000000_add_index.down.mysql.sql": {Data: []byte("DROP INDEX idx ON documents;")},
+		"20230101000000_create_table.up.sql":      {Data: []byte("CREATE TABLE a (id int);")},
+		"README.md":                               {Data: []byte("not a migration")},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, migrations, 2)
+
+	migrator := migration.New(migrations)
+	assert.Equal(t, "20230101000000", migrator.Migrations[0].Version)
+	assert.Equal(t, "create_table", migrator.Migrations[0].Name)
+	assert.NotNil(t, migrator.Migrations[0].Up)
+	assert.Nil(t, migrator.Migrations[0].Down)
+	assert.Equal(t, "add_index", migrator.Migrations[1].Name)
+	assert.NotNil(t, migrator.Migrations[1].Down)
+
+	_, err = migration.LoadSQL(fstest.MapFS{"create_table.up.sql": {Data: []byte("")}})
+	assert.Error(t, err)
+
+	_, err = migration.LoadSQL(fstest.MapFS{
+		"20230101000000_create_a.up.sql": {Data: []byte("")},
+		"20230101000000_create_b.up.sql": {Data: []byte("")},
+	})
+	assert.Error(t, err)
+}
+
+func TestMigrationCreateSQL(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	paths, err := migration.CreateSQL(dir, "add_index", now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "20230102030405_add_index.up.sql"),
+		filepath.Join(dir, "20230102030405_add_index.down.sql"),
+	}, paths)
+
+	_, err = os.Stat(paths[0])
+	assert.NoError(t, err)
+
+	_, err = migration.CreateSQL(dir, "Add Index", now)
+	assert.Error(t, err)
+}
+
+func TestMigrationWriteSQL(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	paths, err := migration.WriteSQL(dir, "fix_drift", "postgres", now, "ALTER TABLE a ADD b int;\n", "ALTER TABLE a DROP b;\n")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "20230102030405_fix_drift.up.postgres.sql"),
+		filepath.Join(dir, "20230102030405_fix_drift.down.postgres.sql"),
+	}, paths)
+
+	content, err := os.ReadFile(paths[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "-- up migration of 20230102030405_fix_drift.\nALTER TABLE a ADD b int;\n", string(content))
+
+	// The written files are loaded as the statements of the driver.
+	migrations, err := migration.LoadSQL(os.DirFS(dir))
+	assert.NoError(t, err)
+	assert.Len(t, migrations, 1)
+	assert.Equal(t, "fix_drift", migrations[0].Name)
+}
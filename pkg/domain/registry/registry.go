@@ -1,9 +1,8 @@
 package registry
 
 import (
-	"fmt"
-	"log"
-	"os"
+	"micro/pkg/configurator"
+	"reflect"
 
 	"gorm.io/gorm"
 )
@@ -24,50 +23,72 @@ type Registry struct {
 	Table    []Table
 }
 
+// BeforeAutoMigrateInterface is implemented by the entity which need to prepare its existing rows
+// before its schema is migrated, e.g. before the type of a column is changed.
+type BeforeAutoMigrateInterface interface {
+	BeforeAutoMigrate(db *gorm.DB) error
+}
+
+// AfterAutoMigrateInterface is implemented by the entity which need to change its schema after it is migrated,
+// e.g. to create an index the gorm index tag can not describe.
+type AfterAutoMigrateInterface interface {
+	AfterAutoMigrate(db *gorm.DB) error
+}
+
 // Interface provides contract which is need to be implemented.
 type Interface interface {
 	AutoMigrate(db *gorm.DB) error
-	ResetDatabase(db *gorm.DB) error
+	ResetDatabase(db *gorm.DB, config *configurator.Config, opts ...ResetOption) error
 }
 
 // AutoMigrate is a function uses to run auto migrate based on the schema of the Entity.
 func (r *Registry) AutoMigrate(db *gorm.DB) error {
-	var err error
-
 	for _, model := range r.Entities {
+		err := beforeAutoMigrate(db, model.Entity)
+		if err != nil {
+			return err
+		}
+
 		err = db.AutoMigrate(model.Entity)
 		if err != nil {
-			log.Fatal(err)
+			return err
+		}
+
+		err = afterAutoMigrate(db, model.Entity)
+		if err != nil {
+			return err
 		}
 	}
 
-	return err
+	return nil
 }
 
-// ResetDatabase is a function uses to reset all table on the current database.
-// It will be used for at test mode.
-func (r *Registry) ResetDatabase(db *gorm.DB) error {
-	var err error
-
-	if os.Getenv("APP_ENV") == "production" {
+// beforeAutoMigrate call BeforeAutoMigrate of the entity when it is implemented.
+// The entities are registered as value, so the pointer of the entity is checked as well.
+func beforeAutoMigrate(db *gorm.DB, model interface{}) error {
+	migrator, ok := toPointer(model).(BeforeAutoMigrateInterface)
+	if !ok {
 		return nil
 	}
 
-	for _, table := range r.Table {
-		errDrop := db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", table.Name)).Error
-		if errDrop != nil {
-			err = errDrop
-			log.Fatal(err)
-		}
+	return migrator.BeforeAutoMigrate(db)
+}
+
+// afterAutoMigrate call AfterAutoMigrate of the entity when it is implemented.
+func afterAutoMigrate(db *gorm.DB, model interface{}) error {
+	migrator, ok := toPointer(model).(AfterAutoMigrateInterface)
+	if !ok {
+		return nil
 	}
 
-	for _, model := range r.Entities {
-		errMigrate := db.AutoMigrate(model.Entity)
-		if errMigrate != nil {
-			err = errMigrate
-			log.Fatal(err)
-		}
+	return migrator.AfterAutoMigrate(db)
+}
+
+// toPointer return the pointer of the entity which is registered as value.
+func toPointer(model interface{}) interface{} {
+	if reflect.TypeOf(model).Kind() == reflect.Ptr {
+		return model
 	}
 
-	return err
+	return reflect.New(reflect.TypeOf(model)).Interface()
 }
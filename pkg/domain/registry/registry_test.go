@@ -1,9 +1,41 @@
 package registry_test
 
-import "testing"
+import (
+	"errors"
+	"micro/pkg/configurator"
+	"micro/pkg/domain/registry"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func TestRegistryResetDatabase(t *testing.T) {
-	// TODO TestRegistryResetDatabase
+	config := &configurator.Config{DBTestConfig: configurator.DBTestConfig{DBName: "micro_test"}}
+	assert.True(t, errors.Is(registry.CheckResetAllowed(config), registry.ErrResetNotTestMode))
+
+	config.TestMode = true
+	assert.NoError(t, registry.CheckResetAllowed(config))
+
+	for _, name := range []string{"micro", "micro_latest", "contest", "production"} {
+		config.DBTestConfig.DBName = name
+		assert.True(t, errors.Is(registry.CheckResetAllowed(config), registry.ErrResetUnsafeDatabase), name)
+	}
+
+	for _, name := range []string{"test", "test_micro", "micro-test", "MICRO_TEST_1"} {
+		config.DBTestConfig.DBName = name
+		assert.NoError(t, registry.CheckResetAllowed(config), name)
+	}
+
+	config.DBTestConfig.DBDriver = "sqlite"
+	for _, name := range []string{":memory:", "micro_test.db", "tmp/micro_test.sqlite", "file:test.db"} {
+		config.DBTestConfig.DBName = name
+		assert.NoError(t, registry.CheckResetAllowed(config), name)
+	}
+
+	for _, name := range []string{"micro.db", "test/micro.db"} {
+		config.DBTestConfig.DBName = name
+		assert.True(t, errors.Is(registry.CheckResetAllowed(config), registry.ErrResetUnsafeDatabase), name)
+	}
 }
 
 func TestRegistryAutoMigrate(t *testing.T) {
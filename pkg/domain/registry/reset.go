@@ -0,0 +1,209 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"micro/pkg/configurator"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrResetNotTestMode is returned when the database is reset outside of the test mode.
+	ErrResetNotTestMode = errors.New("common.error.database.reset.not_test_mode")
+
+	// ErrResetUnsafeDatabase is returned when the name of the test database does not match SafeTestDatabasePattern,
+	// or the db is not connected to the test database.
+	ErrResetUnsafeDatabase = errors.New("common.error.database.reset.unsafe_database")
+)
+
+// sqliteMemory is the name of the SQLite in-memory database, it is always allowed to be reset.
+const sqliteMemory = ":memory:"
+
+// SafeTestDatabasePattern match the name of the database which is allowed to be reset, e.g. micro_test or test_micro.
+var SafeTestDatabasePattern = regexp.MustCompile(`(^|[_-])test([_-]|$)`)
+
+// ResetOption return resetOptions with option.
+type ResetOption func(o *resetOptions)
+
+// resetOptions holds the options of ResetDatabase.
+type resetOptions struct {
+	truncate bool
+}
+
+// WithTruncate is a function uses to empty the tables instead of dropping and migrating them again, it is faster
+// but the schema must be migrated already. The foreign key checks are disabled while the tables are truncated.
+func WithTruncate() ResetOption {
+	return func(o *resetOptions) {
+		o.truncate = true
+	}
+}
+
+// CheckResetAllowed return error unless the config is on the test mode and the name of the test database
+// match SafeTestDatabasePattern. The SQLite database is matched by the file name without the extension,
+// and the SQLite in-memory database is always allowed.
+func CheckResetAllowed(config *configurator.Config) error {
+	if !config.TestMode {
+		return ErrResetNotTestMode
+	}
+
+	name := testDatabaseName(config)
+	if name != sqliteMemory && !SafeTestDatabasePattern.MatchString(strings.ToLower(name)) {
+		return fmt.Errorf("%w: %q", ErrResetUnsafeDatabase, config.DBTestConfig.DBName)
+	}
+
+	return nil
+}
+
+// testDatabaseName return the name of the test database, it is the file name without the extension on SQLite.
+func testDatabaseName(config *configurator.Config) string {
+	name := config.DBTestConfig.DBName
+	if config.DBTestConfig.DBDriver != "sqlite" || name == sqliteMemory {
+		return name
+	}
+
+	name = filepath.Base(strings.TrimPrefix(name, "file:"))
+
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
+
+// currentDatabaseName return the name of the database db is connected to, it is the file name without the
+// extension on SQLite, or sqliteMemory for the in-memory database.
+func currentDatabaseName(db *gorm.DB) string {
+	if db.Dialector.Name() != "sqlite" {
+		return db.Migrator().CurrentDatabase()
+	}
+
+	var seq int
+	var name, file string
+	err := db.Raw("PRAGMA database_list").Row().Scan(&seq, &name, &file)
+	if err != nil {
+		return ""
+	}
+
+	if file == "" {
+		return sqliteMemory
+	}
+
+	file = filepath.Base(file)
+
+	return strings.TrimSuffix(file, filepath.Ext(file))
+}
+
+// ResetDatabase is a function uses to reset the tables of the Registry on the test database.
+// It refuses to reset unless it is allowed by CheckResetAllowed and db is connected to the test database.
+// The tables are dropped and migrated again, or only truncated when WithTruncate is given.
+func (r *Registry) ResetDatabase(db *gorm.DB, config *configurator.Config, opts ...ResetOption) error {
+	err := checkResetDatabase(db, config)
+	if err != nil {
+		return err
+	}
+
+	o := &resetOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.truncate {
+		return r.truncate(db)
+	}
+
+	var tables []interface{}
+	for _, table := range r.Table {
+		tables = append(tables, table.Name)
+	}
+
+	err = db.Migrator().DropTable(tables...)
+	if err != nil {
+		return err
+	}
+
+	return r.AutoMigrate(db)
+}
+
+// BeginTestTransaction is a function uses to isolate a test in a transaction on the test database.
+// The test uses the returned tx and calls rollback when it is done, e.g. by t.Cleanup, so nothing is persisted.
+// The transaction of the repositories run in the savepoints of tx.
+func (r *Registry) BeginTestTransaction(db *gorm.DB, config *configurator.Config) (*gorm.DB, func() error, error) {
+	err := checkResetDatabase(db, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, nil, tx.Error
+	}
+
+	return tx, func() error { return tx.Rollback().Error }, nil
+}
+
+// checkResetDatabase return error unless the reset is allowed and db is connected to the test database.
+func checkResetDatabase(db *gorm.DB, config *configurator.Config) error {
+	err := CheckResetAllowed(config)
+	if err != nil {
+		return err
+	}
+
+	if current := currentDatabaseName(db); current != "" && current != testDatabaseName(config) {
+		return fmt.Errorf("%w: connected to %q", ErrResetUnsafeDatabase, current)
+	}
+
+	return nil
+}
+
+// truncate empty the tables of the Registry, the foreign key checks are disabled while the tables are truncated.
+func (r *Registry) truncate(db *gorm.DB) error {
+	var tables []clause.Table
+	for _, table := range r.Table {
+		tables = append(tables, clause.Table{Name: fmt.Sprint(table.Name)})
+	}
+
+	if len(tables) == 0 {
+		return nil
+	}
+
+	return db.Connection(func(tx *gorm.DB) error {
+		switch tx.Dialector.Name() {
+		case "postgres":
+			var exprs []clause.Expression
+			for _, table := range tables {
+				exprs = append(exprs, clause.Expr{SQL: "?", Vars: []interface{}{table}})
+			}
+
+			return tx.Exec("TRUNCATE TABLE ? RESTART IDENTITY CASCADE", clause.CommaExpression{Exprs: exprs}).Error
+		case "mysql":
+			err := tx.Exec("SET FOREIGN_KEY_CHECKS = 0").Error
+			if err != nil {
+				return err
+			}
+
+			for _, table := range tables {
+				err = tx.Exec("TRUNCATE TABLE ?", table).Error
+				if err != nil {
+					break
+				}
+			}
+
+			errChecks := tx.Exec("SET FOREIGN_KEY_CHECKS = 1").Error
+			if err != nil {
+				return err
+			}
+
+			return errChecks
+		default:
+			for _, table := range tables {
+				err := tx.Exec("DELETE FROM ?", table).Error
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	})
+}
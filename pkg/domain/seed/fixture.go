@@ -0,0 +1,48 @@
+package seed
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadFixture is a function uses to decode the YAML or JSON fixture file of fsys into v by the extension of the name.
+// The unknown fields are rejected, so a typo on the fixture does not silently seed an empty value.
+func LoadFixture(fsys fs.FS, name string, v interface{}) error {
+	content, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return err
+	}
+
+	switch path.Ext(name) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		err = decoder.Decode(v)
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(v)
+	default:
+		return fmt.Errorf("fixture %s: unsupported extension", name)
+	}
+	if err != nil {
+		return fmt.Errorf("fixture %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// IsFixture return true when the name has the extension of the fixture file.
+func IsFixture(name string) bool {
+	switch path.Ext(name) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+
+	return false
+}
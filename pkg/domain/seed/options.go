@@ -0,0 +1,23 @@
+package seed
+
+// Option is a function uses to select the seeds to be executed.
+type Option func(*options)
+
+type options struct {
+	name string
+	env  string
+}
+
+// WithName is a function uses to execute only the seed with the name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.name = name
+	}
+}
+
+// WithEnv is a function uses to execute only the seeds of the environment and the seeds of every environment.
+func WithEnv(env string) Option {
+	return func(o *options) {
+		o.env = env
+	}
+}
@@ -1,15 +1,36 @@
 package seed
 
 import (
-	"log"
+	"errors"
+	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 )
 
-// Seed is a struct uses to holds the seeder name and the Run function to execute the seeder.
+// ErrSeedNotFound is returned when there is no seed with the requested name.
+var ErrSeedNotFound = errors.New("common.error.database.seed_not_found")
+
+// Seed is a struct uses to holds the seeder name, the environments, and the Run function to execute the seeder.
+// The name must be unique, it is recorded once the seed is applied. The seed runs on every environment when
+// Env is empty. Rollback is optional, it undoes the changes of Run outside the database, e.g. the uploaded objects,
+// when the transaction of the seed is rolled back.
 type Seed struct {
-	Name string
-	Run  func(*gorm.DB) error
+	Name     string
+	Env      []string
+	Run      func(*gorm.DB) error
+	Rollback func() error
+}
+
+// SeedApplied is the row of seeds_applied table which records the applied seed.
+type SeedApplied struct {
+	Name      string `gorm:"primaryKey;size:255"`
+	AppliedAt time.Time
+}
+
+// TableName return the table name of the applied seeds.
+func (SeedApplied) TableName() string {
+	return "seeds_applied"
 }
 
 // Seeder is a struct uses to holds collection of Seed.
@@ -22,17 +43,98 @@ func New(seeds []Seed) *Seeder {
 	return &Seeder{Seeds: seeds}
 }
 
-// Seed is a functions uses to execute all available Seeder.
-func (s *Seeder) Seed(db *gorm.DB) error {
-	var err error
+// Seed is a functions uses to execute the seeds which are not applied yet in the order they are registered.
+// Each seed is executed in its own transaction and the applied seeds are returned.
+func (s *Seeder) Seed(db *gorm.DB, opts ...Option) ([]Seed, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	selected, err := s.filter(o)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.AutoMigrate(&SeedApplied{})
+	if err != nil {
+		return nil, err
+	}
+
+	var seedsApplied []SeedApplied
+	err = db.Find(&seedsApplied).Error
+	if err != nil {
+		return nil, err
+	}
+
+	isApplied := make(map[string]bool, len(seedsApplied))
+	for _, seedApplied := range seedsApplied {
+		isApplied[seedApplied.Name] = true
+	}
+
+	var applied []Seed
+	for _, seed := range selected {
+		if isApplied[seed.Name] {
+			continue
+		}
+
+		seed := seed
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if errRun := seed.Run(tx); errRun != nil {
+				return errRun
+			}
+
+			return tx.Create(&SeedApplied{Name: seed.Name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			if seed.Rollback != nil {
+				if errRollback := seed.Rollback(); errRollback != nil {
+					return applied, fmt.Errorf("seed %s: %w, rollback: %v", seed.Name, err, errRollback)
+				}
+			}
 
+			return applied, fmt.Errorf("seed %s: %w", seed.Name, err)
+		}
+
+		applied = append(applied, seed)
+	}
+
+	return applied, nil
+}
+
+// filter return the seeds which match the name and the environment of the options.
+func (s *Seeder) filter(o *options) ([]Seed, error) {
+	var selected []Seed
 	for _, seed := range s.Seeds {
-		errSeed := seed.Run(db)
-		if errSeed != nil {
-			err = errSeed
-			log.Fatalf("Running seed '%s', failed with error: %s", seed.Name, err)
+		if o.name != "" && seed.Name != o.name {
+			continue
+		}
+
+		if o.env != "" && !seed.hasEnv(o.env) {
+			continue
+		}
+
+		selected = append(selected, seed)
+	}
+
+	if o.name != "" && len(selected) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrSeedNotFound, o.name)
+	}
+
+	return selected, nil
+}
+
+// hasEnv return true when the seed runs on the environment.
+func (s Seed) hasEnv(env string) bool {
+	if len(s.Env) == 0 {
+		return true
+	}
+
+	for _, e := range s.Env {
+		if e == env {
+			return true
 		}
 	}
 
-	return err
+	return false
 }
@@ -1,7 +1,47 @@
 package seed_test
 
-import "testing"
+import (
+	"micro/pkg/domain/seed"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fixture struct {
+	Categories []struct {
+		Slug string `yaml:"slug" json:"slug"`
+		Size int64  `yaml:"size" json:"size"`
+	} `yaml:"categories" json:"categories"`
+}
 
 func TestSeedSeed(t *testing.T) {
-	// TODO TestSeedSeed
+	seeder := seed.New([]seed.Seed{{Name: "default/categories"}})
+
+	_, err := seeder.Seed(nil, seed.WithName("dev/documents"))
+	assert.ErrorIs(t, err, seed.ErrSeedNotFound)
+}
+
+func TestSeedLoadFixture(t *testing.T) {
+	fsys := fstest.MapFS{
+		"categories.yaml": {Data: []byte("categories:\n  - slug: original\n    size: 1024\n")},
+		"categories.json": {Data: []byte(`{"categories": [{"slug": "sign", "size": 2048}]}`)},
+		"unknown.yaml":    {Data: []byte("categories:\n  - slug: original\n    sizes: 1024\n")},
+		"categories.txt":  {Data: []byte("")},
+	}
+
+	var fromYAML fixture
+	err := seed.LoadFixture(fsys, "categories.yaml", &fromYAML)
+	assert.NoError(t, err)
+	assert.Equal(t, "original", fromYAML.Categories[0].Slug)
+	assert.Equal(t, int64(1024), fromYAML.Categories[0].Size)
+
+	var fromJSON fixture
+	err = seed.LoadFixture(fsys, "categories.json", &fromJSON)
+	assert.NoError(t, err)
+	assert.Equal(t, "sign", fromJSON.Categories[0].Slug)
+
+	assert.Error(t, seed.LoadFixture(fsys, "unknown.yaml", &fixture{}))
+	assert.Error(t, seed.LoadFixture(fsys, "categories.txt", &fixture{}))
+	assert.False(t, seed.IsFixture("categories.txt"))
 }
@@ -0,0 +1,207 @@
+package exception
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Kind is the kind of Error, it decides the gRPC code and the HTTP status the error is presented with.
+type Kind int
+
+// The kinds of Error.
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindConflict
+	KindValidation
+	KindPermissionDenied
+	KindUnauthenticated
+	KindFailedPrecondition
+	KindAborted
+	KindResourceExhausted
+	KindUnavailable
+	KindDeadlineExceeded
+	KindVersionConflict
+	KindCanceled
+)
+
+// statusClientClosedRequest is the HTTP status of the request which is canceled by the client, it is not defined by
+// net/http.
+const statusClientClosedRequest = 499
+
+// kindStatus is the gRPC code and the HTTP status of a kind.
+type kindStatus struct {
+	name       string
+	code       codes.Code
+	httpStatus int
+}
+
+// kindStatuses is the single mapping of the kinds which is used by the gRPC presenter and the REST error middleware.
+var kindStatuses = map[Kind]kindStatus{
+	KindInternal:           {name: "internal", code: codes.Internal, httpStatus: http.StatusInternalServerError},
+	KindNotFound:           {name: "not_found", code: codes.NotFound, httpStatus: http.StatusNotFound},
+	KindConflict:           {name: "conflict", code: codes.AlreadyExists, httpStatus: http.StatusConflict},
+	KindValidation:         {name: "validation", code: codes.InvalidArgument, httpStatus: http.StatusUnprocessableEntity},
+	KindPermissionDenied:   {name: "permission_denied", code: codes.PermissionDenied, httpStatus: http.StatusForbidden},
+	KindUnauthenticated:    {name: "unauthenticated", code: codes.Unauthenticated, httpStatus: http.StatusUnauthorized},
+	KindFailedPrecondition: {name: "failed_precondition", code: codes.FailedPrecondition, httpStatus: http.StatusConflict},
+	KindAborted:            {name: "aborted", code: codes.Aborted, httpStatus: http.StatusConflict},
+	KindResourceExhausted:  {name: "resource_exhausted", code: codes.ResourceExhausted, httpStatus: http.StatusTooManyRequests},
+	KindUnavailable:        {name: "unavailable", code: codes.Unavailable, httpStatus: http.StatusServiceUnavailable},
+	KindDeadlineExceeded:   {name: "deadline_exceeded", code: codes.DeadlineExceeded, httpStatus: http.StatusGatewayTimeout},
+	KindVersionConflict:    {name: "version_conflict", code: codes.Aborted, httpStatus: http.StatusPreconditionFailed},
+	KindCanceled:           {name: "canceled", code: codes.Canceled, httpStatus: statusClientClosedRequest},
+}
+
+// String return the name of the kind, e.g. not_found.
+func (k Kind) String() string {
+	return k.status().name
+}
+
+// Code return the gRPC code of the kind.
+func (k Kind) Code() codes.Code {
+	return k.status().code
+}
+
+// HTTPStatus return the HTTP status of the kind.
+func (k Kind) HTTPStatus() int {
+	return k.status().httpStatus
+}
+
+func (k Kind) status() kindStatus {
+	if status, ok := kindStatuses[k]; ok {
+		return status
+	}
+
+	return kindStatuses[KindInternal]
+}
+
+// The errors of each kind, errors.Is matches every Error of the same kind with them.
+var (
+	ErrInternal           = New(KindInternal, "error.common.internal_server_error")
+	ErrNotFound           = New(KindNotFound, "error.common.not_found")
+	ErrConflict           = New(KindConflict, "error.common.conflict")
+	ErrValidation         = New(KindValidation, "error.common.unprocessable_entity")
+	ErrPermissionDenied   = New(KindPermissionDenied, "error.common.permission_denied")
+	ErrUnauthenticated    = New(KindUnauthenticated, "common.error.unauthorized")
+	ErrFailedPrecondition = New(KindFailedPrecondition, "error.common.failed_precondition")
+	ErrAborted            = New(KindAborted, "error.common.aborted")
+	ErrResourceExhausted  = New(KindResourceExhausted, "error.common.resource_exhausted")
+	ErrUnavailable        = New(KindUnavailable, "error.common.service_unavailable")
+	ErrDeadlineExceeded   = New(KindDeadlineExceeded, "error.common.deadline_exceeded")
+	ErrVersionConflict    = New(KindVersionConflict, "error.common.version_conflict")
+	ErrCanceled           = New(KindCanceled, "error.common.canceled")
+)
+
+// kindErrors holds the error of each kind.
+var kindErrors = map[Kind]*Error{
+	KindInternal:           ErrInternal,
+	KindNotFound:           ErrNotFound,
+	KindConflict:           ErrConflict,
+	KindValidation:         ErrValidation,
+	KindPermissionDenied:   ErrPermissionDenied,
+	KindUnauthenticated:    ErrUnauthenticated,
+	KindFailedPrecondition: ErrFailedPrecondition,
+	KindAborted:            ErrAborted,
+	KindResourceExhausted:  ErrResourceExhausted,
+	KindUnavailable:        ErrUnavailable,
+	KindDeadlineExceeded:   ErrDeadlineExceeded,
+	KindVersionConflict:    ErrVersionConflict,
+	KindCanceled:           ErrCanceled,
+}
+
+// DetailsInterface is implemented by the error which carries the field details, e.g. the field of the conflict.
+type DetailsInterface interface {
+	ErrorDetails() ErrorRPCList
+}
+
+// Error is the domain error, the message is the translation key which is presented to the client.
+// The repositories return it instead of the errors of the drivers, so the transports do not depend on the storage.
+type Error struct {
+	Kind    Kind
+	Message string
+	Details ErrorRPCList
+	Err     error
+}
+
+// New is a constructor will initialize Error of the kind with the message.
+func New(kind Kind, message string) *Error {
+	return &Error{
+		Kind:    kind,
+		Message: message,
+	}
+}
+
+// Wrap is a constructor will initialize Error of the kind which is caused by err, err is kept for errors.Is and
+// errors.As but its message is not presented.
+func Wrap(kind Kind, message string, err error) *Error {
+	return &Error{
+		Kind:    kind,
+		Message: message,
+		Err:     err,
+	}
+}
+
+// WithDetails return a copy of Error with the field details, the copy still matches Error with errors.Is.
+func (e *Error) WithDetails(details ErrorRPCList) *Error {
+	return &Error{
+		Kind:    e.Kind,
+		Message: e.Message,
+		Details: details,
+		Err:     e,
+	}
+}
+
+// Error return the message.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap return the cause of Error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is return true when target is the error of the kind, e.g. errors.Is(err, exception.ErrNotFound).
+func (e *Error) Is(target error) bool {
+	return target == kindErrors[e.Kind]
+}
+
+// ErrorDetails return the field details.
+func (e *Error) ErrorDetails() ErrorRPCList {
+	return e.Details
+}
+
+// FromError return Error of err. The context errors are Error of their kind, any other error is ErrInternal which
+// hides the message of err. The field details are taken from the outermost error which carries them.
+func FromError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var errDomain *Error
+	switch {
+	case errors.As(err, &errDomain):
+	case errors.Is(err, context.DeadlineExceeded):
+		errDomain = Wrap(KindDeadlineExceeded, ErrDeadlineExceeded.Message, err)
+	case errors.Is(err, context.Canceled):
+		errDomain = Wrap(KindCanceled, ErrCanceled.Message, err)
+	default:
+		errDomain = Wrap(KindInternal, ErrInternal.Message, err)
+	}
+
+	var errDetails DetailsInterface
+	if errors.As(err, &errDetails) && len(errDetails.ErrorDetails()) > 0 {
+		return &Error{
+			Kind:    errDomain.Kind,
+			Message: errDomain.Message,
+			Details: errDetails.ErrorDetails(),
+			Err:     err,
+		}
+	}
+
+	return errDomain
+}
@@ -0,0 +1,102 @@
+package exception_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"micro/pkg/exception"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+type detailsError struct{}
+
+func (e *detailsError) Error() string {
+	return "details"
+}
+
+func (e *detailsError) Unwrap() error {
+	return exception.ErrConflict
+}
+
+func (e *detailsError) ErrorDetails() exception.ErrorRPCList {
+	return exception.ErrorRPCList{{Field: "slug", Msg: "validation.error.already_exists"}}
+}
+
+func TestExceptionKindStatus(t *testing.T) {
+	testCases := []struct {
+		kind       exception.Kind
+		code       codes.Code
+		httpStatus int
+	}{
+		{kind: exception.KindInternal, code: codes.Internal, httpStatus: http.StatusInternalServerError},
+		{kind: exception.KindNotFound, code: codes.NotFound, httpStatus: http.StatusNotFound},
+		{kind: exception.KindConflict, code: codes.AlreadyExists, httpStatus: http.StatusConflict},
+		{kind: exception.KindValidation, code: codes.InvalidArgument, httpStatus: http.StatusUnprocessableEntity},
+		{kind: exception.KindPermissionDenied, code: codes.PermissionDenied, httpStatus: http.StatusForbidden},
+		{kind: exception.KindUnauthenticated, code: codes.Unauthenticated, httpStatus: http.StatusUnauthorized},
+		{kind: exception.KindFailedPrecondition, code: codes.FailedPrecondition, httpStatus: http.StatusConflict},
+		{kind: exception.KindAborted, code: codes.Aborted, httpStatus: http.StatusConflict},
+		{kind: exception.KindResourceExhausted, code: codes.ResourceExhausted, httpStatus: http.StatusTooManyRequests},
+		{kind: exception.KindUnavailable, code: codes.Unavailable, httpStatus: http.StatusServiceUnavailable},
+		{kind: exception.KindDeadlineExceeded, code: codes.DeadlineExceeded, httpStatus: http.StatusGatewayTimeout},
+		{kind: exception.KindVersionConflict, code: codes.Aborted, httpStatus: http.StatusPreconditionFailed},
+		{kind: exception.KindCanceled, code: codes.Canceled, httpStatus: 499},
+		{kind: exception.Kind(99), code: codes.Internal, httpStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.kind.String(), func(t *testing.T) {
+			assert.Equal(t, tc.code, tc.kind.Code())
+			assert.Equal(t, tc.httpStatus, tc.kind.HTTPStatus())
+		})
+	}
+}
+
+func TestExceptionErrorIs(t *testing.T) {
+	errSubjectNotFound := exception.New(exception.KindNotFound, "error.legal_hold.subject_not_found")
+	err := fmt.Errorf("take subject: %w", errSubjectNotFound)
+
+	assert.EqualError(t, errSubjectNotFound, "error.legal_hold.subject_not_found")
+	assert.True(t, errors.Is(err, errSubjectNotFound))
+	assert.True(t, errors.Is(err, exception.ErrNotFound))
+	assert.False(t, errors.Is(err, exception.ErrConflict))
+
+	cause := errors.New("record not found")
+	errWrapped := exception.Wrap(exception.KindNotFound, exception.ErrNotFound.Message, cause)
+	assert.True(t, errors.Is(errWrapped, cause))
+	assert.EqualError(t, errWrapped, "error.common.not_found")
+
+	errDetails := errSubjectNotFound.WithDetails(exception.ErrorRPCList{{Field: "subject_id"}})
+	assert.True(t, errors.Is(errDetails, errSubjectNotFound))
+	assert.True(t, errors.Is(errDetails, exception.ErrNotFound))
+	assert.Empty(t, errSubjectNotFound.Details)
+	assert.Len(t, errDetails.Details, 1)
+}
+
+func TestExceptionFromError(t *testing.T) {
+	assert.Nil(t, exception.FromError(nil))
+
+	errDomain := exception.FromError(fmt.Errorf("find: %w", exception.ErrNotFound))
+	assert.Equal(t, exception.KindNotFound, errDomain.Kind)
+	assert.Equal(t, "error.common.not_found", errDomain.Message)
+
+	errDomain = exception.FromError(errors.New("dial tcp 10.0.0.1:5432: connection refused"))
+	assert.Equal(t, exception.KindInternal, errDomain.Kind)
+	assert.EqualError(t, errDomain, "error.common.internal_server_error")
+
+	errDomain = exception.FromError(fmt.Errorf("query: %w", context.DeadlineExceeded))
+	assert.Equal(t, exception.KindDeadlineExceeded, errDomain.Kind)
+
+	errDomain = exception.FromError(context.Canceled)
+	assert.Equal(t, exception.KindCanceled, errDomain.Kind)
+	assert.Equal(t, codes.Canceled, errDomain.Kind.Code())
+
+	errDomain = exception.FromError(fmt.Errorf("save: %w", &detailsError{}))
+	assert.Equal(t, exception.KindConflict, errDomain.Kind)
+	assert.Equal(t, "error.common.conflict", errDomain.Message)
+	assert.Equal(t, exception.ErrorRPCList{{Field: "slug", Msg: "validation.error.already_exists"}}, errDomain.Details)
+}
@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"micro/pkg/filestore"
+	"micro/pkg/filestore/object"
+)
+
+// ErrObjectNotFound is returned when there is no object on the path.
+var ErrObjectNotFound = errors.New("filestore.driver.memory: object not found")
+
+// Driver is an in-memory filestore driver uses by the tests, the objects are kept by the path.
+type Driver struct {
+	mu      sync.RWMutex
+	objects map[string][]byte
+}
+
+// NewDriver is a constructor will initialize Driver.
+func NewDriver() *Driver {
+	return &Driver{objects: make(map[string][]byte)}
+}
+
+// Type assertions to make sure Driver already implement filestore.Interface.
+var _ filestore.Interface = &Driver{}
+
+// GenerateGetObjectSignedURL is a method uses to generate GET signed URL.
+func (d *Driver) GenerateGetObjectSignedURL(objectPath string) (string, error) {
+	return fmt.Sprintf("memory://%s", objectPath), nil
+}
+
+// GeneratePutObjectSignedURL is a method uses to generate PUT signed URL.
+func (d *Driver) GeneratePutObjectSignedURL(m *object.Metadata) (string, error) {
+	return fmt.Sprintf("memory://%s", m.Filepath()), nil
+}
+
+// GetObject is a method uses to get an object.
+func (d *Driver) GetObject(objectPath string) ([]byte, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	content, ok := d.objects[objectPath]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrObjectNotFound, objectPath)
+	}
+
+	return append([]byte{}, content...), nil
+}
+
+// GetObjectURL is a method uses to get an object URL.
+func (d *Driver) GetObjectURL(objectPath string) (string, error) {
+	return d.GenerateGetObjectSignedURL(objectPath)
+}
+
+// PutObject is a method uses to upload an object, the content is kept for both put methods.
+func (d *Driver) PutObject(m *object.Metadata) (*object.Metadata, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.objects[m.Filepath()] = append([]byte{}, m.Content...)
+
+	return m, nil
+}
+
+// DuplicateObject is a method uses to duplicate an object to specific path.
+func (d *Driver) DuplicateObject(sourcePath string, targetPath string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	content, ok := d.objects[sourcePath]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrObjectNotFound, sourcePath)
+	}
+
+	d.objects[targetPath] = append([]byte{}, content...)
+
+	return nil
+}
+
+// DeleteObject is a method uses to delete an object.
+func (d *Driver) DeleteObject(objectPath string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.objects, objectPath)
+
+	return nil
+}
+
+// HasObject return true when there is an object on the path.
+func (d *Driver) HasObject(objectPath string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	_, ok := d.objects[objectPath]
+
+	return ok
+}
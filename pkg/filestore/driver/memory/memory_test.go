@@ -0,0 +1,29 @@
+package memory_test
+
+import (
+	"micro/pkg/filestore/driver/memory"
+	"micro/pkg/filestore/object"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryDriver(t *testing.T) {
+	driver := memory.NewDriver()
+	metadata := object.NewFromByteSlice([]byte("%PDF-1.4\n"), "original", object.IncludeSlug())
+
+	_, err := driver.PutObject(metadata)
+	assert.NoError(t, err)
+	assert.True(t, driver.HasObject(metadata.Filepath()))
+
+	content, err := driver.GetObject(metadata.Filepath())
+	assert.NoError(t, err)
+	assert.Equal(t, metadata.Content, content)
+
+	assert.NoError(t, driver.DuplicateObject(metadata.Filepath(), "copy.pdf"))
+	assert.True(t, driver.HasObject("copy.pdf"))
+
+	assert.NoError(t, driver.DeleteObject(metadata.Filepath()))
+	_, err = driver.GetObject(metadata.Filepath())
+	assert.ErrorIs(t, err, memory.ErrObjectNotFound)
+}
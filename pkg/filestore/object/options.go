@@ -72,3 +72,17 @@ func WithSource(source string) Option {
 		m.Source = source
 	}
 }
+
+// WithOriginalName is a function to set Metadata.OriginalName.
+func WithOriginalName(originalName string) Option {
+	return func(m *Metadata) {
+		m.OriginalName = originalName
+	}
+}
+
+// WithPutMethod is a function to set Metadata.PutMethod.
+func WithPutMethod(putMethod PutMethod) Option {
+	return func(m *Metadata) {
+		m.PutMethod = putMethod
+	}
+}
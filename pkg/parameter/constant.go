@@ -4,10 +4,13 @@ const (
 	defaultPage        = 1
 	defaultPerPage     = 5
 	maxPerPage         = 25
+	maxInValues        = 100
 	defaultSearchBy    = "AND"
 	defaultOrderBy     = "created_at"
 	defaultOrderMethod = "desc"
 	defaultDateRangeBy = "created_at"
+	defaultTrashed     = "false"
+	defaultSkipTotal   = "false"
 
 	and = "AND"
 	or  = "OR"
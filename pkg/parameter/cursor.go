@@ -0,0 +1,127 @@
+package parameter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const cursorIDColumn = "id"
+
+var errInvalidCursor = errors.New("cursor is invalid")
+
+// Cursor represent the position of a row on keyset pagination.
+// It holds the values of the order columns and the ID of the row, then encoded as an opaque string.
+type Cursor struct {
+	Values []interface{} `json:"v"`
+	ID     string        `json:"id"`
+
+	// Prev means the rows before the cursor position are requested.
+	Prev bool `json:"p,omitempty"`
+}
+
+// orderColumn represent a column uses to order the rows.
+type orderColumn struct {
+	Name string
+	Desc bool
+}
+
+// EncodeCursor encode Cursor to an opaque string.
+func EncodeCursor(cursor *Cursor) string {
+	encoded, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// DecodeCursor decode an opaque string produced by EncodeCursor to Cursor.
+func DecodeCursor(s string) (*Cursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	var cursor Cursor
+	err = json.Unmarshal(decoded, &cursor)
+	if err != nil || cursor.ID == "" {
+		return nil, errInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// sqlValues return the values of the cursor ready to be used on SQL query, the time values are parsed as time.Time.
+func (c *Cursor) sqlValues() []interface{} {
+	var values []interface{}
+	for _, value := range c.Values {
+		s, ok := value.(string)
+		if !ok {
+			values = append(values, value)
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			values = append(values, value)
+			continue
+		}
+
+		values = append(values, t)
+	}
+
+	return append(values, c.ID)
+}
+
+// buildCursorCondition build the keyset condition of the cursor on the order columns, e.g.
+// (created_at < ?) OR (created_at = ? AND id < ?) for the next rows ordered by created_at desc, id desc.
+// The cursor with a NULL value is invalid, the condition would never match, so the nullable columns are not sortable.
+func buildCursorCondition(columns []orderColumn, cursor *Cursor) (string, []interface{}, error) {
+	values := cursor.sqlValues()
+	if len(values) != len(columns) {
+		return "", nil, errInvalidCursor
+	}
+
+	for _, value := range values {
+		if value == nil {
+			return "", nil, errInvalidCursor
+		}
+	}
+
+	var conditions []string
+	var conditionValues []interface{}
+	for i, column := range columns {
+		var keys []string
+		for j := 0; j < i; j++ {
+			keys = append(keys, fmt.Sprintf("%s = ?", columns[j].Name))
+			conditionValues = append(conditionValues, values[j])
+		}
+
+		operator := ">"
+		if column.Desc != cursor.Prev {
+			operator = "<"
+		}
+
+		keys = append(keys, fmt.Sprintf("%s %s ?", column.Name, operator))
+		conditionValues = append(conditionValues, values[i])
+		conditions = append(conditions, "("+strings.Join(keys, " AND ")+")")
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", conditionValues, nil
+}
+
+// buildOrder build the order of the columns, the order is reversed to fetch the rows before the cursor.
+func buildOrder(columns []orderColumn, reversed bool) string {
+	var orders []string
+	for _, column := range columns {
+		method := "asc"
+		if column.Desc != reversed {
+			method = "desc"
+		}
+
+		orders = append(orders, fmt.Sprintf("%s %s", column.Name, method))
+	}
+
+	return strings.Join(orders, ", ")
+}
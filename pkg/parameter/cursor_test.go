@@ -0,0 +1,19 @@
+package parameter_test
+
+import (
+	"micro/pkg/parameter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParameterEncodeDecodeCursor(t *testing.T) {
+	cursor := &parameter.Cursor{Values: []interface{}{"2021-01-01T00:00:00Z"}, ID: "1", Prev: true}
+
+	decoded, err := parameter.DecodeCursor(parameter.EncodeCursor(cursor))
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	_, err = parameter.DecodeCursor("not a cursor")
+	assert.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package parameter
+
+import (
+	"fmt"
+	"time"
+)
+
+// Dialect is the SQL dialect the conditions are built for, it is the name of the gorm dialector, e.g. postgres.
+// The empty Dialect builds the conditions which work on both Postgres and MySQL.
+type Dialect string
+
+const (
+	DialectPostgres Dialect = "postgres"
+	DialectMySQL    Dialect = "mysql"
+	DialectSQLite   Dialect = "sqlite"
+)
+
+// likeEscape return the ESCAPE clause of the LIKE condition which value is escaped by likeEscaper.
+// Postgres and MySQL escape by backslash by default, SQLite has no escape character unless it is given.
+func (d Dialect) likeEscape() string {
+	if d == DialectSQLite {
+		return ` ESCAPE '\'`
+	}
+
+	return ""
+}
+
+// compareField return the field and the placeholder of the comparison on the value.
+// SQLite stores the time as text, so the time is compared by julianday instead of by text.
+func (d Dialect) compareField(field string, value string) (string, string) {
+	if d == DialectSQLite && isTimeValue(value) {
+		return "julianday(" + field + ")", "julianday(?)"
+	}
+
+	return field, "?"
+}
+
+// dateRange return the condition of the field between the start and the end date.
+func (d Dialect) dateRange(field string, start string, end string) string {
+	if d == DialectSQLite {
+		return fmt.Sprintf("julianday(%s) BETWEEN julianday('%s') AND julianday('%s')", field, start, end)
+	}
+
+	return fmt.Sprintf("%s BETWEEN '%s' AND '%s'", field, start, end)
+}
+
+// isTimeValue return true when the value is a date or a RFC3339 time.
+func isTimeValue(value string) bool {
+	if _, err := time.Parse("2006-01-02", value); err == nil {
+		return true
+	}
+
+	_, err := time.Parse(time.RFC3339, value)
+
+	return err == nil
+}
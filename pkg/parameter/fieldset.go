@@ -0,0 +1,73 @@
+package parameter
+
+import (
+	"micro/pkg/exception"
+	"micro/pkg/validator"
+	"strings"
+)
+
+// ParseFields split the fields parameter, e.g. id,name,slug into the requested fields.
+func ParseFields(s string) []string {
+	var fields []string
+	seen := make(map[string]bool)
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field != "" && !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// ValidateFields validate the requested fields against the selectable fields.
+func ValidateFields(requested []string, fields Fields) exception.ErrorValidators {
+	validation := validator.New()
+	setFieldsRules(validation, requested, fields)
+
+	return validation.Validate()
+}
+
+// setFieldsRules set the rules of the requested fields, each field must be selectable.
+func setFieldsRules(validation *validator.Validator, requested []string, fields Fields) {
+	var selectable []interface{}
+	for field := range fields.SelectableFields() {
+		selectable = append(selectable, field)
+	}
+
+	for _, field := range requested {
+		validation.Set("fields", field, validation.AddRule().IsLowerAlphaUnderscore().In(selectable...).Apply())
+	}
+}
+
+// SelectColumns return the columns needed by the requested fields, the ID and the cursor columns are always selected.
+// It returns nil when the fields are not requested, which means all columns are selected.
+func (p *SQLQueryParameters) SelectColumns(fields Fields) []string {
+	if len(p.Fields) == 0 {
+		return nil
+	}
+
+	var columns []string
+	seen := make(map[string]bool)
+	add := func(column string) {
+		if !seen[column] {
+			seen[column] = true
+			columns = append(columns, column)
+		}
+	}
+
+	add(cursorIDColumn)
+	for _, column := range p.CursorColumns {
+		add(column)
+	}
+
+	selectable := fields.SelectableFields()
+	for _, field := range p.Fields {
+		for _, column := range selectable[field] {
+			add(column)
+		}
+	}
+
+	return columns
+}
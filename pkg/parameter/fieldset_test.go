@@ -0,0 +1,25 @@
+package parameter_test
+
+import (
+	"micro/pkg/parameter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParameterSelectColumns(t *testing.T) {
+	sourceParameters := parameter.SourceParameters{
+		Page:        1,
+		PerPage:     10,
+		OrderBy:     "created_at",
+		OrderMethod: "desc",
+		Fields:      "desc, name,desc",
+	}
+
+	sqlQueryParameters := sourceParameters.BuildParameter()
+	assert.Equal(t, []string{"desc", "name"}, sqlQueryParameters.Fields)
+	assert.Equal(t, []string{"id", "created_at", "description", "name"}, sqlQueryParameters.SelectColumns(&fields{}))
+
+	sourceParameters.Fields = ""
+	assert.Nil(t, sourceParameters.BuildParameter().SelectColumns(&fields{}))
+}
@@ -0,0 +1,170 @@
+package parameter
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldType represent the type of filterable field uses to validate the value of the filter.
+type FieldType string
+
+const (
+	FieldTypeString FieldType = "string"
+	FieldTypeNumber FieldType = "number"
+	FieldTypeTime   FieldType = "time"
+	FieldTypeBool   FieldType = "bool"
+)
+
+// Fields is a sets of function implemented by the entity to tell the fields allowed on the query parameters.
+// The filterable field which type is not listed on FilterableFieldTypes is a FieldTypeString.
+// SelectableFields map the field of the response to the columns needed to build it.
+type Fields interface {
+	FilterableFields() []interface{}
+	FilterableFieldTypes() map[string]FieldType
+	SortableFields() []interface{}
+	TimeFields() []interface{}
+	SelectableFields() map[string][]string
+}
+
+// The operators of the filter query string, e.g. in[field]=a,b.
+const (
+	operatorEqual      = "equal"
+	operatorNot        = "not"
+	operatorLike       = "like"
+	operatorIn         = "in"
+	operatorGt         = "gt"
+	operatorGte        = "gte"
+	operatorLt         = "lt"
+	operatorLte        = "lte"
+	operatorIsNull     = "is_null"
+	operatorStartsWith = "starts_with"
+	operatorIEqual     = "iequal"
+	operatorILike      = "ilike"
+)
+
+// filterOperators hold the operators in addition to equal[], not[] and like[] in the order the conditions are built.
+var filterOperators = []string{
+	operatorIn,
+	operatorGt,
+	operatorGte,
+	operatorLt,
+	operatorLte,
+	operatorIsNull,
+	operatorStartsWith,
+	operatorIEqual,
+	operatorILike,
+}
+
+// comparisonOperators hold the SQL operator of the range operators.
+var comparisonOperators = map[string]string{
+	operatorGt:  ">",
+	operatorGte: ">=",
+	operatorLt:  "<",
+	operatorLte: "<=",
+}
+
+// likeEscaper escape the wildcard characters of the value uses on LIKE condition.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// splitInValues split the value of in[] operator by comma.
+func splitInValues(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+// buildFilterCondition build the condition of the filter operator on the field for the dialect.
+// It returns an empty key when the value cannot be used by the operator.
+func buildFilterCondition(dialect Dialect, operator string, field string, value string) (string, interface{}) {
+	switch operator {
+	case operatorEqual:
+		return field + " = ?", value
+	case operatorNot:
+		return field + " != ?", value
+	case operatorLike:
+		return field + " LIKE ?", "%" + value + "%"
+	case operatorIn:
+		values := splitInValues(value)
+		if len(values) == 0 {
+			return "", nil
+		}
+		return field + " IN ?", values
+	case operatorGt, operatorGte, operatorLt, operatorLte:
+		compareField, placeholder := dialect.compareField(field, value)
+		return compareField + " " + comparisonOperators[operator] + " " + placeholder, value
+	case operatorIsNull:
+		switch value {
+		case "true":
+			return field + " IS NULL", nil
+		case "false":
+			return field + " IS NOT NULL", nil
+		}
+	case operatorStartsWith:
+		return field + " LIKE ?" + dialect.likeEscape(), likeEscaper.Replace(value) + "%"
+	case operatorIEqual:
+		return "LOWER(" + field + ") = LOWER(?)", value
+	case operatorILike:
+		return "LOWER(" + field + ") LIKE LOWER(?)" + dialect.likeEscape(), "%" + likeEscaper.Replace(value) + "%"
+	}
+
+	return "", nil
+}
+
+// fieldsOfTypes return the filterable fields which type is one of the types.
+func fieldsOfTypes(fields Fields, types ...FieldType) []interface{} {
+	var filtered []interface{}
+	fieldTypes := fields.FilterableFieldTypes()
+	for _, field := range fields.FilterableFields() {
+		fieldType, ok := fieldTypes[field.(string)]
+		if !ok {
+			fieldType = FieldTypeString
+		}
+
+		for _, t := range types {
+			if fieldType == t {
+				filtered = append(filtered, field)
+				break
+			}
+		}
+	}
+
+	return filtered
+}
+
+// validFieldValue is a closure uses to validate the value of the filter based on the type of the field.
+func validFieldValue(fieldType FieldType) func(value interface{}) error {
+	return func(value interface{}) error {
+		s, _ := value.(string)
+		if s == "" {
+			return nil
+		}
+
+		switch fieldType {
+		case FieldTypeNumber:
+			if _, err := strconv.ParseFloat(s, 64); err != nil {
+				return errors.New("validation.error.must_be_valid_number")
+			}
+		case FieldTypeTime:
+			if _, err := time.Parse("2006-01-02", s); err == nil {
+				return nil
+			}
+			if _, err := time.Parse(time.RFC3339, s); err != nil {
+				return errors.New("validation.error.must_be_valid_time")
+			}
+		case FieldTypeBool:
+			if s != "true" && s != "false" {
+				return errors.New("validation.error.must_be_valid_boolean")
+			}
+		}
+
+		return nil
+	}
+}
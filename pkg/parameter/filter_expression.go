@@ -0,0 +1,148 @@
+package parameter
+
+import (
+	"encoding/json"
+	"errors"
+	"micro/pkg/validator"
+	"strings"
+)
+
+const (
+	maxFilterExpressionDepth = 5
+	maxFilterExpressionNodes = 50
+)
+
+var errInvalidFilterExpression = errors.New("validation.error.must_be_valid_filter")
+
+// FilterExpression represent a node of the filter expression tree.
+// A node is either a group which holds And or Or children, or a condition of the Operator on the Field, e.g.
+//
+//	{"and":[{"or":[{"field":"slug","op":"equal","value":"a"},{"field":"slug","op":"equal","value":"b"}]},{"field":"name","op":"like","value":"x"}]}
+//
+// The operators are the same as the operators of the filter query string, the values of the in operator are
+// given by Values.
+type FilterExpression struct {
+	And      []*FilterExpression `json:"and,omitempty"`
+	Or       []*FilterExpression `json:"or,omitempty"`
+	Field    string              `json:"field,omitempty"`
+	Operator string              `json:"op,omitempty"`
+	Value    string              `json:"value,omitempty"`
+	Values   []string            `json:"values,omitempty"`
+}
+
+// ParseFilterExpression parse the JSON filter expression to FilterExpression.
+func ParseFilterExpression(s string) (*FilterExpression, error) {
+	var expression FilterExpression
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&expression); err != nil {
+		return nil, errInvalidFilterExpression
+	}
+
+	return &expression, nil
+}
+
+// isGroup return true when the node is a group of And or Or children.
+func (e *FilterExpression) isGroup() bool {
+	return len(e.And) > 0 || len(e.Or) > 0
+}
+
+// children return the And and the Or children of the node.
+func (e *FilterExpression) children() []*FilterExpression {
+	var children []*FilterExpression
+	children = append(children, e.And...)
+
+	return append(children, e.Or...)
+}
+
+// value return the value of the condition, the values of the in operator are joined by comma.
+func (e *FilterExpression) value() string {
+	if e.Operator == operatorIn && len(e.Values) > 0 {
+		return strings.Join(e.Values, ",")
+	}
+
+	return e.Value
+}
+
+// countNodes return the number of nodes of the expression tree.
+func (e *FilterExpression) countNodes() int {
+	count := 1
+	for _, child := range e.children() {
+		if child != nil {
+			count += child.countNodes()
+		}
+	}
+
+	return count
+}
+
+// compile compile the expression tree to a parameterized condition of the dialect, the field names must be
+// validated beforehand.
+func (e *FilterExpression) compile(dialect Dialect) (string, []interface{}) {
+	if !e.isGroup() {
+		key, value := buildFilterCondition(dialect, e.Operator, e.Field, e.value())
+		if value == nil {
+			return key, nil
+		}
+
+		return key, []interface{}{value}
+	}
+
+	var keys []string
+	var values []interface{}
+	for _, group := range []struct {
+		children  []*FilterExpression
+		separator string
+	}{{e.And, " AND "}, {e.Or, " OR "}} {
+		var groupKeys []string
+		for _, child := range group.children {
+			if child == nil {
+				continue
+			}
+
+			key, value := child.compile(dialect)
+			if key != "" {
+				groupKeys = append(groupKeys, key)
+				values = append(values, value...)
+			}
+		}
+
+		if len(groupKeys) > 0 {
+			keys = append(keys, "("+strings.Join(groupKeys, group.separator)+")")
+		}
+	}
+
+	return strings.Join(keys, " AND "), values
+}
+
+// setRules set the validation rules of each condition of the expression tree.
+func (e *FilterExpression) setRules(validation *validator.Validator, fields Fields, depth int) {
+	if depth == 1 && e.countNodes() > maxFilterExpressionNodes {
+		validation.Set("filter", e.Operator, validation.AddRule().ByFunc(invalidFilterExpression).Apply())
+		return
+	}
+
+	if depth > maxFilterExpressionDepth || (e.isGroup() && (e.Field != "" || e.Operator != "")) {
+		validation.Set("filter", e.Operator, validation.AddRule().ByFunc(invalidFilterExpression).Apply())
+		return
+	}
+
+	if !e.isGroup() {
+		setFilterRules(validation, fields, e.Operator, e.Field, e.value())
+		return
+	}
+
+	for _, child := range e.children() {
+		if child == nil {
+			validation.Set("filter", "", validation.AddRule().ByFunc(invalidFilterExpression).Apply())
+			continue
+		}
+
+		child.setRules(validation, fields, depth+1)
+	}
+}
+
+// invalidFilterExpression is a closure uses to reject the filter expression which cannot be parsed or is malformed.
+func invalidFilterExpression(interface{}) error {
+	return errInvalidFilterExpression
+}
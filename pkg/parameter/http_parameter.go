@@ -14,9 +14,16 @@ import (
 //  - per_page
 //  - order_by
 //  - order_method
+//  - sort
+//  - trashed
+//  - cursor
+//  - skip_total
+//  - filter, a JSON filter expression
+//  - fields
 // 	- equal[]
 // 	- not[]
 // 	- like[]
+// 	- in[], gt[], gte[], lt[], lte[], is_null[], starts_with[], iequal[], ilike[]
 // 	- date_start
 // 	- date_end
 // 	- date_range_by
@@ -27,9 +34,15 @@ func NewHTTPParameters(c *gin.Context) *SQLQueryParameters {
 	perPage, _ := strconv.Atoi(c.DefaultQuery("per_page", strconv.Itoa(defaultPerPage)))
 	orderBy := c.DefaultQuery("order_by", defaultOrderBy)
 	orderMethod := c.DefaultQuery("order_method", defaultOrderMethod)
+	sort := c.DefaultQuery("sort", "")
 	dateRangeBy := c.DefaultQuery("date_range_by", defaultDateRangeBy)
 	dateStart := c.DefaultQuery("date_start", "")
 	dateEnd := c.DefaultQuery("date_end", "")
+	trashed := c.DefaultQuery("trashed", defaultTrashed)
+	cursor := c.DefaultQuery("cursor", "")
+	skipTotal := c.DefaultQuery("skip_total", defaultSkipTotal)
+	filter := c.DefaultQuery("filter", "")
+	fields := c.DefaultQuery("fields", "")
 	queryStrings := c.Request.URL.Query()
 
 	sourceParameters := &SourceParameters{
@@ -38,9 +51,15 @@ func NewHTTPParameters(c *gin.Context) *SQLQueryParameters {
 		PerPage:         perPage,
 		OrderBy:         orderBy,
 		OrderMethod:     orderMethod,
+		Sort:            sort,
 		DateRangeBy:     dateRangeBy,
 		DateStart:       dateStart,
 		DateEnd:         dateEnd,
+		Trashed:         trashed,
+		Cursor:          cursor,
+		SkipTotal:       skipTotal,
+		Filter:          filter,
+		Fields:          fields,
 		QueryStrings:    queryStrings,
 	}
 
@@ -1,18 +1,45 @@
 package parameter
 
 // ResponseMetadata represent response key meta on REST api request uses to store information or metadata about per_page, page, and
-// total page based on the query.
+// total page based on the query. Total is omitted when the total is skipped, NextCursor and PrevCursor are set on keyset pagination.
 type ResponseMetadata struct {
-	PerPage int   `json:"per_page"`
-	Page    int   `json:"page"`
-	Total   int64 `json:"total"`
+	PerPage    int    `json:"per_page"`
+	Page       int    `json:"page"`
+	Total      *int64 `json:"total,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
 
 // NewMeta construct of metadata for the response key meta.
 func NewMeta(p *SQLQueryParameters, total int64) *ResponseMetadata {
-	return &ResponseMetadata{
+	meta := &ResponseMetadata{
 		Page:    p.Page,
 		PerPage: p.PerPage,
-		Total:   total,
 	}
+	if !p.SkipTotal {
+		meta.Total = &total
+	}
+
+	return meta
+}
+
+// WithCursors set the next and the previous cursor of the metadata.
+func (m *ResponseMetadata) WithCursors(next *Cursor, prev *Cursor) *ResponseMetadata {
+	if next != nil {
+		m.NextCursor = EncodeCursor(next)
+	}
+	if prev != nil {
+		m.PrevCursor = EncodeCursor(prev)
+	}
+
+	return m
+}
+
+// GetTotal return the total rows, it returns zero when the total is skipped.
+func (m *ResponseMetadata) GetTotal() int64 {
+	if m.Total == nil {
+		return 0
+	}
+
+	return *m.Total
 }
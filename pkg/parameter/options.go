@@ -28,6 +28,48 @@ func WithConditionalFilter(queryKey string, queryValue []interface{}) Option {
 	}
 }
 
+// WithTrashed is a function to set Trashed to the Option.
+// Trashed means only soft-deleted rows will be listed.
+func WithTrashed(trashed bool) Option {
+	return func(sqp *SQLQueryParameters) {
+		sqp.Trashed = trashed
+	}
+}
+
+// WithCursor is a function to set Cursor, CursorKey, and CursorValue to the Option.
+// CursorKey and CursorValue hold the keyset condition to fetch the rows after or before the Cursor.
+func WithCursor(cursor *Cursor, cursorKey string, cursorValue []interface{}) Option {
+	return func(sqp *SQLQueryParameters) {
+		sqp.Cursor = cursor
+		sqp.CursorKey = cursorKey
+		sqp.CursorValue = cursorValue
+	}
+}
+
+// WithCursorColumns is a function to set CursorColumns to the Option.
+// CursorColumns are the order columns which values are encoded into the next and the previous cursor.
+func WithCursorColumns(columns []string) Option {
+	return func(sqp *SQLQueryParameters) {
+		sqp.CursorColumns = columns
+	}
+}
+
+// WithSkipTotal is a function to set SkipTotal to the Option.
+// SkipTotal means the total rows will not be counted.
+func WithSkipTotal(skipTotal bool) Option {
+	return func(sqp *SQLQueryParameters) {
+		sqp.SkipTotal = skipTotal
+	}
+}
+
+// WithFields is a function to set Fields to the Option.
+// Fields are the requested fields of the response, only the columns needed by the fields are selected.
+func WithFields(fields []string) Option {
+	return func(sqp *SQLQueryParameters) {
+		sqp.Fields = fields
+	}
+}
+
 // WithDateRange is a function to set DateRange to the Option.
 func WithDateRange(dateRange string) Option {
 	return func(sqp *SQLQueryParameters) {
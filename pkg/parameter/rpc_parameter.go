@@ -1,20 +1,35 @@
 package parameter
 
-import "micro/pkg/util"
+import (
+	"micro/pkg/util"
+	"net/url"
+	"strconv"
+	"strings"
+)
 
 // RPCParameters represent parameters.
+// Equal, Not, Like, and Filter are formatted as query string, e.g. in[name]=a,b&gte[size]=1024 on Filter.
 type RPCParameters struct {
 	SearchCondition string
 	Page            int
 	PerPage         int
 	OrderBy         string
 	OrderMethod     string
+	Sort            string
 	Equal           string
 	Not             string
 	Like            string
+	Filter          string
 	DateRangeBy     string
 	DateStart       string
 	DateEnd         string
+	Trashed         bool
+	Cursor          string
+	SkipTotal       bool
+	Fields          []string
+
+	// FilterExpression is the nested filter expression converted from the typed rpc request.
+	FilterExpression *FilterExpression
 }
 
 // ToSQLQueryParameters convert RPCParameters to SQLQueryParameters.
@@ -24,13 +39,28 @@ func (rp *RPCParameters) ToSQLQueryParameters() *SQLQueryParameters {
 	perPage := rp.PerPage
 	orderBy := rp.OrderBy
 	orderMethod := rp.OrderMethod
-	equal, _ := util.ParseStringToQueryString(rp.Equal)
-	not, _ := util.ParseStringToQueryString(rp.Not)
-	like, _ := util.ParseStringToQueryString(rp.Like)
+	sort := rp.Sort
+	var invalidQueryStrings []string
+	parseQueryString := func(name string, query string) url.Values {
+		values, err := util.ParseStringToQueryString(query)
+		if err != nil {
+			invalidQueryStrings = append(invalidQueryStrings, name)
+		}
+
+		return values
+	}
+	equal := parseQueryString("equal", rp.Equal)
+	not := parseQueryString("not", rp.Not)
+	like := parseQueryString("like", rp.Like)
+	filter := parseQueryString("filter", rp.Filter)
 	dateRangeBy := rp.DateRangeBy
 	dateStart := rp.DateStart
 	dateEnd := rp.DateEnd
-	queryStrings := util.MergeQueryString(equal, not, like)
+	trashed := strconv.FormatBool(rp.Trashed)
+	cursor := rp.Cursor
+	skipTotal := strconv.FormatBool(rp.SkipTotal)
+	fields := strings.Join(rp.Fields, ",")
+	queryStrings := util.MergeQueryString(equal, not, like, filter)
 
 	sourceParameters := &SourceParameters{
 		SearchCondition: searchCondition,
@@ -38,10 +68,18 @@ func (rp *RPCParameters) ToSQLQueryParameters() *SQLQueryParameters {
 		PerPage:         perPage,
 		OrderBy:         orderBy,
 		OrderMethod:     orderMethod,
+		Sort:            sort,
 		DateRangeBy:     dateRangeBy,
 		DateStart:       dateStart,
 		DateEnd:         dateEnd,
+		Trashed:         trashed,
+		Cursor:          cursor,
+		SkipTotal:       skipTotal,
+		Fields:          fields,
 		QueryStrings:    queryStrings,
+
+		FilterExpression:    rp.FilterExpression,
+		InvalidQueryStrings: invalidQueryStrings,
 	}
 
 	return sourceParameters.BuildParameter()
@@ -1,7 +1,26 @@
 package parameter_test
 
-import "testing"
+import (
+	"micro/pkg/parameter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func TestParameterRpcParametersToSqlQueryParameters(t *testing.T) {
-	// TODO TestParameterRpcParametersToSqlQueryParameters
+	rpcParameters := parameter.RPCParameters{
+		Page:        1,
+		PerPage:     10,
+		OrderBy:     "created_at",
+		OrderMethod: "desc",
+		Equal:       "equal[name]=foo",
+		Filter:      "gte[size]=1024",
+	}
+
+	sqlQueryParameters := rpcParameters.ToSQLQueryParameters()
+	assert.Equal(t, "name = ? AND size >= ?", sqlQueryParameters.QueryKey)
+	assert.Empty(t, sqlQueryParameters.ValidateParameter(&fields{}))
+
+	rpcParameters.Equal = "equal[name]=%zz"
+	assert.NotEmpty(t, rpcParameters.ToSQLQueryParameters().ValidateParameter(&fields{}))
 }
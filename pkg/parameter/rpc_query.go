@@ -0,0 +1,170 @@
+package parameter
+
+import (
+	"fmt"
+	"micro/pkg/exception"
+	commonv1 "micro/transport/grpc/common/v1"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// rpcFilterOperators map the typed filter operator of the rpc request to the operator of the filter query string.
+var rpcFilterOperators = map[commonv1.FilterOperator]string{
+	commonv1.FilterOperator_FILTER_OPERATOR_EQUAL:       operatorEqual,
+	commonv1.FilterOperator_FILTER_OPERATOR_NOT:         operatorNot,
+	commonv1.FilterOperator_FILTER_OPERATOR_LIKE:        operatorLike,
+	commonv1.FilterOperator_FILTER_OPERATOR_IN:          operatorIn,
+	commonv1.FilterOperator_FILTER_OPERATOR_GT:          operatorGt,
+	commonv1.FilterOperator_FILTER_OPERATOR_GTE:         operatorGte,
+	commonv1.FilterOperator_FILTER_OPERATOR_LT:          operatorLt,
+	commonv1.FilterOperator_FILTER_OPERATOR_LTE:         operatorLte,
+	commonv1.FilterOperator_FILTER_OPERATOR_IS_NULL:     operatorIsNull,
+	commonv1.FilterOperator_FILTER_OPERATOR_STARTS_WITH: operatorStartsWith,
+	commonv1.FilterOperator_FILTER_OPERATOR_IEQUAL:      operatorIEqual,
+	commonv1.FilterOperator_FILTER_OPERATOR_ILIKE:       operatorILike,
+}
+
+// NewRPCQueryParameters convert the typed query of the list rpc to SQLQueryParameters and validate it against the fields.
+// The returned ErrorRPCList holds the field violations, it is empty when the query is valid.
+func NewRPCQueryParameters(query *commonv1.Query, fields Fields) (*SQLQueryParameters, exception.ErrorRPCList) {
+	var violations exception.ErrorValidators
+	pagination := query.GetPagination()
+
+	sourceParameters := &SourceParameters{
+		SearchCondition: defaultSearchBy,
+		Page:            int(pagination.GetPage()),
+		PerPage:         int(pagination.GetPerPage()),
+		OrderBy:         defaultOrderBy,
+		OrderMethod:     defaultOrderMethod,
+		Trashed:         strconv.FormatBool(query.GetTrashed()),
+		Cursor:          pagination.GetCursor(),
+		SkipTotal:       strconv.FormatBool(pagination.GetSkipTotal()),
+		Fields:          strings.Join(query.GetFields().GetPaths(), ","),
+		QueryStrings:    make(url.Values),
+	}
+
+	if sourceParameters.Page == 0 {
+		sourceParameters.Page = defaultPage
+	}
+	if sourceParameters.PerPage == 0 {
+		sourceParameters.PerPage = defaultPerPage
+	}
+
+	if query.GetSearchCondition() == commonv1.SearchCondition_SEARCH_CONDITION_OR {
+		sourceParameters.SearchCondition = or
+	}
+
+	if dateRange := query.GetDateRange(); dateRange != nil {
+		sourceParameters.DateRangeBy = dateRange.GetField()
+		sourceParameters.DateStart = dateRange.GetStart()
+		sourceParameters.DateEnd = dateRange.GetEnd()
+	}
+
+	var sort []string
+	for _, sortField := range query.GetSort() {
+		if sortField.GetDesc() {
+			sort = append(sort, "-"+sortField.GetField())
+			continue
+		}
+		sort = append(sort, sortField.GetField())
+	}
+	sourceParameters.Sort = strings.Join(sort, ",")
+
+	for i, filter := range query.GetFilters() {
+		field := fmt.Sprintf("filters[%d]", i)
+		operator, values, violation := fromRPCFilter(field, filter)
+		if violation != nil {
+			violations = append(violations, *violation)
+			continue
+		}
+
+		key := fmt.Sprintf("%s[%s]", operator, filter.GetField())
+		sourceParameters.QueryStrings[key] = append(sourceParameters.QueryStrings[key], values...)
+	}
+
+	if query.GetFilterExpression() != nil {
+		filterExpression, expressionViolations := fromRPCFilterExpression("filter_expression", query.GetFilterExpression())
+		sourceParameters.FilterExpression = filterExpression
+		violations = append(violations, expressionViolations...)
+	}
+
+	sqlQueryParameters := sourceParameters.BuildParameter()
+	violations = append(violations, sqlQueryParameters.ValidateParameter(fields)...)
+
+	return sqlQueryParameters, violations.ToErrorRPCList()
+}
+
+// FromRPCFilterExpression convert the typed filter expression of the rpc request to FilterExpression.
+// The malformed conditions are kept as is, so they are rejected by SQLQueryParameters.ValidateParameter.
+func FromRPCFilterExpression(expression *commonv1.FilterExpression) *FilterExpression {
+	filterExpression, _ := fromRPCFilterExpression("filter_expression", expression)
+
+	return filterExpression
+}
+
+// fromRPCFilterExpression convert the typed filter expression and collect the violations of the conditions.
+func fromRPCFilterExpression(field string, expression *commonv1.FilterExpression) (*FilterExpression, exception.ErrorValidators) {
+	var violations exception.ErrorValidators
+	if expression == nil {
+		return nil, violations
+	}
+
+	filterExpression := &FilterExpression{}
+	if condition := expression.GetCondition(); condition != nil {
+		operator, values, violation := fromRPCFilter(field+".condition", condition)
+		if violation != nil {
+			violations = append(violations, *violation)
+		}
+
+		filterExpression.Field = condition.GetField()
+		filterExpression.Operator = operator
+		if operator == operatorIn {
+			filterExpression.Values = values
+		} else if len(values) > 0 {
+			filterExpression.Value = values[0]
+		}
+	}
+
+	for _, group := range []struct {
+		name     string
+		children []*commonv1.FilterExpression
+		dest     *[]*FilterExpression
+	}{{"and", expression.GetAnd(), &filterExpression.And}, {"or", expression.GetOr(), &filterExpression.Or}} {
+		for i, child := range group.children {
+			childExpression, childViolations := fromRPCFilterExpression(fmt.Sprintf("%s.%s[%d]", field, group.name, i), child)
+			*group.dest = append(*group.dest, childExpression)
+			violations = append(violations, childViolations...)
+		}
+	}
+
+	return filterExpression, violations
+}
+
+// fromRPCFilter convert the typed filter to the operator and the values of the filter query string.
+// The in operator takes every value, the other operators take a single value.
+func fromRPCFilter(field string, filter *commonv1.Filter) (string, []string, *exception.ErrorValidator) {
+	operator, ok := rpcFilterOperators[filter.GetOperator()]
+	if !ok {
+		return "", nil, &exception.ErrorValidator{
+			Field: field + ".operator",
+			Msg:   "validation.error.must_be_valid_filter_operator",
+			Data:  map[string]interface{}{field + ".operator": filter.GetOperator().String()},
+		}
+	}
+
+	values := filter.GetValues()
+	if operator == operatorIn {
+		return operator, []string{strings.Join(values, ",")}, nil
+	}
+
+	if len(values) != 1 {
+		return "", nil, &exception.ErrorValidator{
+			Field: field + ".values",
+			Msg:   "validation.error.must_have_single_value",
+			Data:  map[string]interface{}{field + ".values": values},
+		}
+	}
+
+	return operator, values, nil
+}
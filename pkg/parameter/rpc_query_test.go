@@ -0,0 +1,49 @@
+package parameter_test
+
+import (
+	"micro/pkg/parameter"
+	commonv1 "micro/transport/grpc/common/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestParameterNewRPCQueryParameters(t *testing.T) {
+	query := &commonv1.Query{
+		Pagination:      &commonv1.Pagination{Page: 2, PerPage: 10},
+		Sort:            []*commonv1.SortField{{Field: "name"}, {Field: "created_at", Desc: true}},
+		SearchCondition: commonv1.SearchCondition_SEARCH_CONDITION_OR,
+		Filters: []*commonv1.Filter{
+			{Field: "name", Operator: commonv1.FilterOperator_FILTER_OPERATOR_IN, Values: []string{"a", "b"}},
+			{Field: "size", Operator: commonv1.FilterOperator_FILTER_OPERATOR_GTE, Values: []string{"1024"}},
+		},
+		FilterExpression: &commonv1.FilterExpression{
+			Or: []*commonv1.FilterExpression{
+				{Condition: &commonv1.Filter{Field: "name", Operator: commonv1.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{"x"}}},
+				{Condition: &commonv1.Filter{Field: "created_at", Operator: commonv1.FilterOperator_FILTER_OPERATOR_IS_NULL, Values: []string{"true"}}},
+			},
+		},
+		Fields: &fieldmaskpb.FieldMask{Paths: []string{"id", "name"}},
+	}
+
+	sqlQueryParameters, violations := parameter.NewRPCQueryParameters(query, &fields{})
+	assert.Empty(t, violations)
+	assert.Equal(t, 10, sqlQueryParameters.Offset)
+	assert.Equal(t, "name asc, created_at desc, id desc", sqlQueryParameters.Order)
+	assert.Equal(t, "(name IN ? OR size >= ?) AND (name = ? OR created_at IS NULL)", sqlQueryParameters.QueryKey)
+	assert.Equal(t, []string{"id", "name"}, sqlQueryParameters.Fields)
+
+	query.Filters = []*commonv1.Filter{
+		{Field: "name", Values: []string{"a"}},
+		{Field: "name", Operator: commonv1.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{"a", "b"}},
+		{Field: "password", Operator: commonv1.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{"a"}},
+	}
+
+	_, violations = parameter.NewRPCQueryParameters(query, &fields{})
+	var violationFields []string
+	for _, violation := range violations {
+		violationFields = append(violationFields, violation.Field)
+	}
+	assert.Equal(t, []string{"filters[0].operator", "filters[1].values", "equal"}, violationFields)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -14,10 +15,25 @@ type SourceParameters struct {
 	PerPage         int
 	OrderBy         string
 	OrderMethod     string
+	Sort            string
 	DateRangeBy     string
 	DateStart       string
 	DateEnd         string
+	Trashed         string
+	Cursor          string
+	SkipTotal       string
+	Filter          string
+	Fields          string
 	QueryStrings    url.Values
+
+	// FilterExpression is the parsed Filter, it is given directly by the typed request, e.g. rpc request.
+	FilterExpression *FilterExpression
+
+	// InvalidQueryStrings hold the names of the query string parameters which cannot be parsed, e.g. equal on rpc request.
+	InvalidQueryStrings []string
+
+	// Dialect is the SQL dialect the conditions are built for, see SQLQueryParameters.ForDialect.
+	Dialect Dialect
 }
 
 type queryConditionParameters struct {
@@ -30,14 +46,28 @@ type queryPaginationParameters struct {
 	offset int
 }
 
+// reSortField is uses to make sure only a column name is placed into the order clause.
+var reSortField = regexp.MustCompile("^[a-z_]+$")
+
 // conditionQueryStringMap holds map of pairs search condition by [field]=value.
 type conditionQueryStringMap map[int]map[string]interface{}
 
+// sortedIndexes return the indexes of conditionQueryStringMap in order, so the conditions are built deterministically.
+func (m conditionQueryStringMap) sortedIndexes() []int {
+	var indexes []int
+	for i := range m {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	return indexes
+}
+
 // toQueryString convert conditionQueryString to http GET request query string.
 func toQueryString(key string, conditionQueryStringMap conditionQueryStringMap) string {
 	var queryString = ""
-	for _, querySlice := range conditionQueryStringMap {
-		for field, value := range querySlice {
+	for _, i := range conditionQueryStringMap.sortedIndexes() {
+		for field, value := range conditionQueryStringMap[i] {
 			if value != "" || value != nil {
 				unescapedValue, _ := url.QueryUnescape(value.(string))
 				queryString = queryString + "&" + key + "[" + field + "]=" + unescapedValue
@@ -48,6 +78,16 @@ func toQueryString(key string, conditionQueryStringMap conditionQueryStringMap)
 	return queryString
 }
 
+// toFiltersQueryString convert the filters of each operator to http GET request query string.
+func toFiltersQueryString(queryFilters map[string]conditionQueryStringMap) string {
+	var queryString = ""
+	for _, operator := range filterOperators {
+		queryString = queryString + toQueryString(operator, queryFilters[operator])
+	}
+
+	return queryString
+}
+
 // BuildParameter is a function uses to build SQLQueryParameters from the SourceParameters that
 // collected http request, rpc request or gQL request.
 func (s *SourceParameters) BuildParameter() *SQLQueryParameters {
@@ -56,11 +96,14 @@ func (s *SourceParameters) BuildParameter() *SQLQueryParameters {
 	var queryEqual = s.buildQueryEqualParameters()
 	var queryNotEqual = s.buildQueryNotEqualParameters()
 	var queryLike = s.buildQueryLikeParameters()
+	var queryFilters = s.buildQueryFilterParameters()
+	var filterExpression = s.buildFilterExpression()
 
 	s.buildSearchCondition()
 	queryConditionParameters.buildEqualParameters(queryEqual)
 	queryConditionParameters.buildNotEqualParameters(queryNotEqual)
 	queryConditionParameters.buildLikeParameters(queryLike)
+	queryConditionParameters.buildFilterParameters(s.Dialect, queryFilters)
 	queryPaginationParameters.buildPaginationParameters(s.Page, s.PerPage)
 
 	var queryParameters = &QueryParameters{
@@ -68,87 +111,85 @@ func (s *SourceParameters) BuildParameter() *SQLQueryParameters {
 		Page:                 s.Page,
 		OrderBy:              s.OrderBy,
 		OrderMethod:          s.OrderMethod,
+		Sort:                 s.Sort,
 		SearchCondition:      s.SearchCondition,
 		DateRangeBy:          s.DateRangeBy,
 		DateStart:            s.DateStart,
 		DateEnd:              s.DateEnd,
+		Trashed:              s.Trashed,
+		Cursor:               s.Cursor,
+		SkipTotal:            s.SkipTotal,
 		Equals:               queryEqual,
 		EqualsQueryString:    toQueryString("equal", queryEqual),
 		Likes:                queryLike,
 		LikesQueryString:     toQueryString("like", queryLike),
 		NotEquals:            queryNotEqual,
 		NotEqualsQueryString: toQueryString("not", queryNotEqual),
+		Filters:              queryFilters,
+		FiltersQueryString:   toFiltersQueryString(queryFilters),
+		Filter:               s.Filter,
+		FilterExpression:     filterExpression,
+		Fields:               s.Fields,
+		InvalidQueryStrings:  s.InvalidQueryStrings,
 	}
 
 	sqlQueryParameters := NewSQLQueryParameters(
 		s.buildQueryParameterOptions(
 			&queryConditionParameters,
 			&queryPaginationParameters,
+			filterExpression,
 		)...)
 
 	sqlQueryParameters.QueryParameters = queryParameters
+	sqlQueryParameters.Dialect = s.Dialect
+	sqlQueryParameters.source = s
 
 	return sqlQueryParameters
 }
 
 func (s *SourceParameters) buildQueryEqualParameters() conditionQueryStringMap {
-	var queryEqual = make(conditionQueryStringMap)
-
-	for key, valueSlice := range s.QueryStrings {
-		reEqual := regexp.MustCompile("equal\\[(.*[a-z])]")
-		reEqualSlice := reEqual.FindStringSubmatch(key)
-		if len(reEqualSlice) > 0 {
-			if len(valueSlice) > 1 {
-				for i, value := range valueSlice {
-					queryEqual[i] = map[string]interface{}{reEqualSlice[1]: value}
-				}
-			} else {
-				queryEqual[0] = map[string]interface{}{reEqualSlice[1]: strings.Join(valueSlice, "")}
-			}
-		}
-	}
-
-	return queryEqual
+	return s.buildQueryOperatorParameters("equal")
 }
 
 func (s *SourceParameters) buildQueryNotEqualParameters() conditionQueryStringMap {
-	var queryNotEqual = make(conditionQueryStringMap)
-
-	for key, valueSlice := range s.QueryStrings {
-		reNotEqual := regexp.MustCompile("not\\[(.*[a-z])]")
-		reNotEqualSlice := reNotEqual.FindStringSubmatch(key)
-		if len(reNotEqualSlice) > 0 {
-			if len(valueSlice) > 1 {
-				for i, value := range valueSlice {
-					queryNotEqual[i] = map[string]interface{}{reNotEqualSlice[1]: value}
-				}
-			} else {
-				queryNotEqual[0] = map[string]interface{}{reNotEqualSlice[1]: strings.Join(valueSlice, "")}
-			}
-		}
+	return s.buildQueryOperatorParameters("not")
+}
+
+func (s *SourceParameters) buildQueryLikeParameters() conditionQueryStringMap {
+	return s.buildQueryOperatorParameters("like")
+}
+
+func (s *SourceParameters) buildQueryFilterParameters() map[string]conditionQueryStringMap {
+	var queryFilters = make(map[string]conditionQueryStringMap)
+	for _, operator := range filterOperators {
+		queryFilters[operator] = s.buildQueryOperatorParameters(operator)
 	}
 
-	return queryNotEqual
+	return queryFilters
 }
 
-func (s *SourceParameters) buildQueryLikeParameters() conditionQueryStringMap {
-	var queryLike = make(conditionQueryStringMap)
-
-	for key, valueSlice := range s.QueryStrings {
-		reLike := regexp.MustCompile("like\\[(.*[a-z])]")
-		reLikeSlice := reLike.FindStringSubmatch(key)
-		if len(reLikeSlice) > 0 {
-			if len(valueSlice) > 1 {
-				for i, value := range valueSlice {
-					queryLike[i] = map[string]interface{}{reLikeSlice[1]: value}
-				}
-			} else {
-				queryLike[0] = map[string]interface{}{reLikeSlice[1]: strings.Join(valueSlice, "")}
+// buildQueryOperatorParameters collect pairs of [field]=value of the operator from the query strings, e.g. equal[name]=value.
+// The query strings are collected in order of the keys, so the conditions are built deterministically.
+func (s *SourceParameters) buildQueryOperatorParameters(operator string) conditionQueryStringMap {
+	var queryOperator = make(conditionQueryStringMap)
+	reOperator := regexp.MustCompile("^" + operator + "\\[(.*[a-z])]$")
+
+	var keys []string
+	for key := range s.QueryStrings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		reOperatorSlice := reOperator.FindStringSubmatch(key)
+		if len(reOperatorSlice) > 0 {
+			for _, value := range s.QueryStrings[key] {
+				queryOperator[len(queryOperator)] = map[string]interface{}{reOperatorSlice[1]: value}
 			}
 		}
 	}
 
-	return queryLike
+	return queryOperator
 }
 
 func (s *SourceParameters) buildSearchCondition() {
@@ -165,20 +206,52 @@ func (s *SourceParameters) buildSearchCondition() {
 	}
 }
 
-func (s *SourceParameters) buildQueryParameterOptions(qcp *queryConditionParameters, qpp *queryPaginationParameters) []Option {
+// buildFilterExpression return the typed filter expression, or parse the JSON filter expression when it is not given.
+func (s *SourceParameters) buildFilterExpression() *FilterExpression {
+	if s.FilterExpression != nil || s.Filter == "" {
+		return s.FilterExpression
+	}
+
+	filterExpression, _ := ParseFilterExpression(s.Filter)
+
+	return filterExpression
+}
+
+func (s *SourceParameters) buildQueryParameterOptions(qcp *queryConditionParameters, qpp *queryPaginationParameters, filterExpression *FilterExpression) []Option {
 	var sqlQueryParameterOption []Option
-	queryOrder := fmt.Sprintf("%s %s", s.OrderBy, s.OrderMethod)
-	queryDateRange := fmt.Sprintf("%s BETWEEN '%s' AND '%s'", s.DateRangeBy, s.DateStart, s.DateEnd)
+	var cursor *Cursor
+	orderColumns := s.buildOrderColumns()
+	queryDateRange := s.Dialect.dateRange(s.DateRangeBy, s.DateStart, s.DateEnd)
+
+	queryKey := strings.Join(qcp.keys, strings.ToUpper(fmt.Sprintf(" %s ", s.SearchCondition)))
+	queryValue := qcp.values
+
+	// The filter expression is combined with the other conditions by AND regardless of the search condition.
+	if filterExpression != nil {
+		filterKey, filterValue := filterExpression.compile(s.Dialect)
+		if filterKey != "" && queryKey != "" {
+			queryKey = fmt.Sprintf("(%s) AND %s", queryKey, filterKey)
+			queryValue = append(queryValue, filterValue...)
+		} else if filterKey != "" {
+			queryKey = filterKey
+			queryValue = filterValue
+		}
+	}
 
-	sqlQueryParameterOption = append(sqlQueryParameterOption,
-		WithConditionalFilter(
-			strings.Join(
-				qcp.keys,
-				strings.ToUpper(fmt.Sprintf(" %s ", s.SearchCondition)),
-			),
-			qcp.values,
-		),
-	)
+	sqlQueryParameterOption = append(sqlQueryParameterOption, WithConditionalFilter(queryKey, queryValue))
+
+	// The cursor takes precedence over the page, the rows are fetched after or before the cursor position.
+	if s.Cursor != "" {
+		decodedCursor, err := DecodeCursor(s.Cursor)
+		if err == nil {
+			cursorKey, cursorValue, err := buildCursorCondition(orderColumns, decodedCursor)
+			if err == nil {
+				cursor = decodedCursor
+				qpp.offset = 0
+				sqlQueryParameterOption = append(sqlQueryParameterOption, WithCursor(cursor, cursorKey, cursorValue))
+			}
+		}
+	}
 
 	sqlQueryParameterOption = append(sqlQueryParameterOption,
 		WithPagination(
@@ -189,7 +262,16 @@ func (s *SourceParameters) buildQueryParameterOptions(qcp *queryConditionParamet
 		),
 	)
 
-	sqlQueryParameterOption = append(sqlQueryParameterOption, WithOrder(queryOrder))
+	var cursorColumns []string
+	for _, column := range orderColumns[:len(orderColumns)-1] {
+		cursorColumns = append(cursorColumns, column.Name)
+	}
+
+	sqlQueryParameterOption = append(sqlQueryParameterOption, WithOrder(buildOrder(orderColumns, cursor != nil && cursor.Prev)))
+	sqlQueryParameterOption = append(sqlQueryParameterOption, WithCursorColumns(cursorColumns))
+	sqlQueryParameterOption = append(sqlQueryParameterOption, WithTrashed(s.Trashed == "true"))
+	sqlQueryParameterOption = append(sqlQueryParameterOption, WithSkipTotal(s.SkipTotal == "true"))
+	sqlQueryParameterOption = append(sqlQueryParameterOption, WithFields(ParseFields(s.Fields)))
 
 	if s.DateRangeBy != "" && s.DateStart != "" && s.DateEnd != "" {
 		sqlQueryParameterOption = append(sqlQueryParameterOption, WithDateRange(queryDateRange))
@@ -198,9 +280,51 @@ func (s *SourceParameters) buildQueryParameterOptions(qcp *queryConditionParamet
 	return sqlQueryParameterOption
 }
 
+// buildOrderColumns build the order columns from the sort parameter, or from the order_by and the order_method
+// when the sort is not given. The ID is always used as the last order column so the order is deterministic.
+func (s *SourceParameters) buildOrderColumns() []orderColumn {
+	var columns []orderColumn
+	if s.Sort == "" {
+		columns = append(columns, orderColumn{Name: s.OrderBy, Desc: strings.EqualFold(s.OrderMethod, "desc")})
+	}
+
+	for _, field := range parseSort(s.Sort) {
+		column := orderColumn{Name: strings.TrimPrefix(field, "-"), Desc: strings.HasPrefix(field, "-")}
+		if !reSortField.MatchString(column.Name) {
+			continue
+		}
+		columns = append(columns, column)
+	}
+
+	// The ID is unique, so the columns after the ID have no effect on the order.
+	for i, column := range columns {
+		if column.Name == cursorIDColumn {
+			return columns[:i+1]
+		}
+	}
+
+	desc := len(columns) > 0 && columns[len(columns)-1].Desc
+
+	return append(columns, orderColumn{Name: cursorIDColumn, Desc: desc})
+}
+
+// parseSort split the sort parameter, e.g. -created_at,name into the sort fields.
+// The field prefixed with - is sorted descending, otherwise it is sorted ascending.
+func parseSort(sort string) []string {
+	var fields []string
+	for _, field := range strings.Split(sort, ",") {
+		field = strings.TrimPrefix(strings.TrimSpace(field), "+")
+		if field != "" {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
 func (q *queryConditionParameters) buildEqualParameters(queryEqual conditionQueryStringMap) *queryConditionParameters {
-	for _, querySlice := range queryEqual {
-		for key, value := range querySlice {
+	for _, i := range queryEqual.sortedIndexes() {
+		for key, value := range queryEqual[i] {
 			if value != "" {
 				q.keys = append(q.keys, key+" = ?")
 				q.values = append(q.values, value)
@@ -212,8 +336,8 @@ func (q *queryConditionParameters) buildEqualParameters(queryEqual conditionQuer
 }
 
 func (q *queryConditionParameters) buildNotEqualParameters(queryNotEqual conditionQueryStringMap) *queryConditionParameters {
-	for _, querySlice := range queryNotEqual {
-		for key, value := range querySlice {
+	for _, i := range queryNotEqual.sortedIndexes() {
+		for key, value := range queryNotEqual[i] {
 			if value != "" {
 				q.keys = append(q.keys, key+" != ?")
 				q.values = append(q.values, value)
@@ -225,8 +349,8 @@ func (q *queryConditionParameters) buildNotEqualParameters(queryNotEqual conditi
 }
 
 func (q *queryConditionParameters) buildLikeParameters(queryLike conditionQueryStringMap) *queryConditionParameters {
-	for _, querySlice := range queryLike {
-		for key, value := range querySlice {
+	for _, i := range queryLike.sortedIndexes() {
+		for key, value := range queryLike[i] {
 			if value != "" {
 				value = "%" + value.(string) + "%"
 				q.keys = append(q.keys, key+" LIKE ?")
@@ -238,6 +362,27 @@ func (q *queryConditionParameters) buildLikeParameters(queryLike conditionQueryS
 	return q
 }
 
+func (q *queryConditionParameters) buildFilterParameters(dialect Dialect, queryFilters map[string]conditionQueryStringMap) *queryConditionParameters {
+	for _, operator := range filterOperators {
+		queryFilter := queryFilters[operator]
+		for _, i := range queryFilter.sortedIndexes() {
+			for key, value := range queryFilter[i] {
+				conditionKey, conditionValue := buildFilterCondition(dialect, operator, key, value.(string))
+				if value == "" || conditionKey == "" {
+					continue
+				}
+
+				q.keys = append(q.keys, conditionKey)
+				if conditionValue != nil {
+					q.values = append(q.values, conditionValue)
+				}
+			}
+		}
+	}
+
+	return q
+}
+
 func (q *queryPaginationParameters) buildPaginationParameters(page int, perPage int) *queryPaginationParameters {
 	if page <= 1 {
 		q.offset = 0
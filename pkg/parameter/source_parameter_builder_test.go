@@ -41,3 +41,128 @@ func TestParameterBuildParameter(t *testing.T) {
 	assert.Equal(t, "name = ? OR name = ? OR name = ? OR name != ? OR name LIKE ?", sqlQueryParameters.QueryKey)
 	assert.Equal(t, "created_at BETWEEN '2021-01-01' AND '2021-12-31'", sqlQueryParameters.DateRange)
 }
+
+func TestParameterBuildParameterWithCursor(t *testing.T) {
+	cursor := parameter.EncodeCursor(&parameter.Cursor{Values: []interface{}{"2021-01-01T00:00:00Z"}, ID: "10"})
+	sourceParameters := parameter.SourceParameters{
+		SearchCondition: "AND",
+		Page:            3,
+		PerPage:         10,
+		OrderBy:         "created_at",
+		OrderMethod:     "desc",
+		Cursor:          cursor,
+		SkipTotal:       "true",
+	}
+
+	sqlQueryParameters := sourceParameters.BuildParameter()
+	assert.Equal(t, 0, sqlQueryParameters.Offset)
+	assert.Equal(t, "created_at desc, id desc", sqlQueryParameters.Order)
+	assert.Equal(t, []string{"created_at"}, sqlQueryParameters.CursorColumns)
+	assert.Equal(t, "((created_at < ?) OR (created_at = ? AND id < ?))", sqlQueryParameters.CursorKey)
+	assert.Len(t, sqlQueryParameters.CursorValue, 3)
+	assert.True(t, sqlQueryParameters.SkipTotal)
+
+	sourceParameters.Cursor = parameter.EncodeCursor(&parameter.Cursor{Values: []interface{}{"2021-01-01T00:00:00Z"}, ID: "10", Prev: true})
+	sqlQueryParameters = sourceParameters.BuildParameter()
+	assert.Equal(t, "created_at asc, id asc", sqlQueryParameters.Order)
+	assert.Equal(t, "((created_at > ?) OR (created_at = ? AND id > ?))", sqlQueryParameters.CursorKey)
+
+	// The cursor of a NULL value would match nothing, it is rejected instead of returning an empty page.
+	sourceParameters.OrderBy = "deleted_at"
+	sourceParameters.Cursor = parameter.EncodeCursor(&parameter.Cursor{Values: []interface{}{nil}, ID: "10"})
+	sqlQueryParameters = sourceParameters.BuildParameter()
+	assert.Nil(t, sqlQueryParameters.Cursor)
+	assert.Empty(t, sqlQueryParameters.CursorKey)
+}
+
+func TestParameterBuildParameterWithSort(t *testing.T) {
+	sourceParameters := parameter.SourceParameters{
+		Page:        1,
+		PerPage:     10,
+		OrderBy:     "created_at",
+		OrderMethod: "desc",
+		Sort:        "-created_at, name",
+	}
+
+	sqlQueryParameters := sourceParameters.BuildParameter()
+	assert.Equal(t, "created_at desc, name asc, id asc", sqlQueryParameters.Order)
+	assert.Equal(t, []string{"created_at", "name"}, sqlQueryParameters.CursorColumns)
+
+	sourceParameters.Sort = "name;drop table,id,created_at"
+	sqlQueryParameters = sourceParameters.BuildParameter()
+	assert.Equal(t, "id asc", sqlQueryParameters.Order)
+
+	sourceParameters.Sort = ""
+	sqlQueryParameters = sourceParameters.BuildParameter()
+	assert.Equal(t, "created_at desc, id desc", sqlQueryParameters.Order)
+}
+
+func TestParameterBuildParameterWithFilters(t *testing.T) {
+	sourceParameters := parameter.SourceParameters{
+		SearchCondition: "AND",
+		Page:            1,
+		PerPage:         10,
+		OrderBy:         "created_at",
+		OrderMethod:     "desc",
+		QueryStrings: map[string][]string{
+			"in[type]":          {"pdf,png"},
+			"gte[size]":         {"1024"},
+			"lt[size]":          {"4096"},
+			"is_null[token]":    {"true"},
+			"starts_with[name]": {"50%_off"},
+			"iequal[name]":      {"Report"},
+			"ilike[name]":       {"report"},
+		},
+	}
+
+	sqlQueryParameters := sourceParameters.BuildParameter()
+	assert.Equal(t, "type IN ? AND size >= ? AND size < ? AND token IS NULL AND name LIKE ? AND LOWER(name) = LOWER(?) AND LOWER(name) LIKE LOWER(?)", sqlQueryParameters.QueryKey)
+	assert.Equal(t, []interface{}{[]string{"pdf", "png"}, "1024", "4096", `50\%\_off%`, "Report", "%report%"}, sqlQueryParameters.QueryValue)
+}
+
+func TestParameterBuildParameterWithFilterExpression(t *testing.T) {
+	sourceParameters := parameter.SourceParameters{
+		SearchCondition: "OR",
+		Page:            1,
+		PerPage:         10,
+		OrderBy:         "created_at",
+		OrderMethod:     "desc",
+		Filter:          `{"and":[{"or":[{"field":"slug","op":"equal","value":"a"},{"field":"slug","op":"equal","value":"b"}]},{"field":"name","op":"like","value":"x"}]}`,
+		QueryStrings: map[string][]string{
+			"equal[type]": {"pdf"},
+			"not[type]":   {"png"},
+		},
+	}
+
+	sqlQueryParameters := sourceParameters.BuildParameter()
+	assert.Equal(t, "(type = ? OR type != ?) AND ((slug = ? OR slug = ?) AND name LIKE ?)", sqlQueryParameters.QueryKey)
+	assert.Equal(t, []interface{}{"pdf", "png", "a", "b", "%x%"}, sqlQueryParameters.QueryValue)
+}
+
+func TestParameterBuildParameterForDialect(t *testing.T) {
+	sourceParameters := parameter.SourceParameters{
+		SearchCondition: "AND",
+		PerPage:         10,
+		OrderBy:         "id",
+		DateRangeBy:     "created_at",
+		DateStart:       "2021-01-01",
+		DateEnd:         "2021-12-31",
+		QueryStrings: map[string][]string{
+			"starts_with[name]": {"a_b"},
+			"gt[created_at]":    {"2021-06-01T00:00:00Z"},
+			"gt[size]":          {"1024"},
+		},
+	}
+
+	sqlQueryParameters := sourceParameters.BuildParameter()
+	assert.Equal(t, "created_at > ? AND size > ? AND name LIKE ?", sqlQueryParameters.QueryKey)
+	assert.Same(t, sqlQueryParameters, sqlQueryParameters.ForDialect("postgres"))
+	assert.Same(t, sqlQueryParameters, sqlQueryParameters.ForDialect("mysql"))
+
+	sqliteParameters := sqlQueryParameters.ForDialect("sqlite")
+	assert.Equal(t, parameter.DialectSQLite, sqliteParameters.Dialect)
+	assert.Equal(t, `julianday(created_at) > julianday(?) AND size > ? AND name LIKE ? ESCAPE '\'`, sqliteParameters.QueryKey)
+	assert.Equal(t, []interface{}{"2021-06-01T00:00:00Z", "1024", `a\_b%`}, sqliteParameters.QueryValue)
+	assert.Equal(t, "julianday(created_at) BETWEEN julianday('2021-01-01') AND julianday('2021-12-31')", sqliteParameters.DateRange)
+	assert.Same(t, sqliteParameters, sqliteParameters.ForDialect("sqlite"))
+}
@@ -9,16 +9,26 @@ type QueryParameters struct {
 	Page                 int
 	OrderBy              string
 	OrderMethod          string
+	Sort                 string
 	SearchCondition      string
 	DateRangeBy          string
 	DateStart            string
 	DateEnd              string
+	Trashed              string
+	Cursor               string
+	SkipTotal            string
 	Equals               conditionQueryStringMap
 	EqualsQueryString    string
 	Likes                conditionQueryStringMap
 	LikesQueryString     string
 	NotEquals            conditionQueryStringMap
 	NotEqualsQueryString string
+	Filters              map[string]conditionQueryStringMap
+	FiltersQueryString   string
+	Filter               string
+	FilterExpression     *FilterExpression
+	Fields               string
+	InvalidQueryStrings  []string
 }
 
 // SQLQueryParameters represent parameters uses to perform conditional filtering, ordering, and
@@ -32,7 +42,18 @@ type SQLQueryParameters struct {
 	DateRange       string
 	QueryKey        string
 	QueryValue      []interface{}
+	Trashed         bool
+	Cursor          *Cursor
+	CursorKey       string
+	CursorValue     []interface{}
+	CursorColumns   []string
+	SkipTotal       bool
+	Fields          []string
 	QueryParameters *QueryParameters
+	Dialect         Dialect
+
+	// source is the SourceParameters the parameters are built from, it is used to build them for another dialect.
+	source *SourceParameters
 }
 
 // NewSQLQueryParameters is a constructor will initialize SQLQueryParameters.
@@ -46,6 +67,23 @@ func NewSQLQueryParameters(options ...Option) *SQLQueryParameters {
 	return sqlQueryParameters
 }
 
+// ForDialect return the parameters built for the dialect, e.g. the name of gorm.Dialector.
+// The parameters are returned as they are when they work on the dialect already or are not built from the source.
+func (q *SQLQueryParameters) ForDialect(dialect string) *SQLQueryParameters {
+	if q.Dialect == Dialect(dialect) || q.source == nil {
+		return q
+	}
+
+	if q.Dialect == "" && (Dialect(dialect) == DialectPostgres || Dialect(dialect) == DialectMySQL) {
+		return q
+	}
+
+	source := *q.source
+	source.Dialect = Dialect(dialect)
+
+	return source.BuildParameter()
+}
+
 // GrabEqualParameterValuesByFieldName return values of Equal parameter.
 func (q *QueryParameters) GrabEqualParameterValuesByFieldName(fieldName string) []interface{} {
 	var values []interface{}
@@ -1,6 +1,7 @@
 package parameter
 
 import (
+	"errors"
 	"fmt"
 	"micro/pkg/exception"
 	"micro/pkg/validator"
@@ -9,43 +10,124 @@ import (
 
 // ValidateParameter is a function uses to validate collected parameters
 // from query strings on HTTP request with GET method.
-func (p *SQLQueryParameters) ValidateParameter(filterableFields []interface{}, timeFields []interface{}) exception.ErrorValidators {
+// The order_by and the sort are validated against the sortable fields, the order_by and the order_method are
+// only required when the sort is not given. The filters are validated against the filterable fields and their types.
+func (p *SQLQueryParameters) ValidateParameter(fields Fields) exception.ErrorValidators {
 	var qp = p.QueryParameters
 
 	validation := validator.New()
 	validation.
 		Set("per_page", qp.PerPage, validation.AddRule().Required().Between(1, 25).Apply()).
 		Set("page", qp.Page, validation.AddRule().Required().MinValue(1).Apply()).
-		Set("order_by", qp.OrderBy, validation.AddRule().Required().In(timeFields...).Apply()).
-		Set("order_method", qp.OrderMethod, validation.AddRule().Required().In("asc", "desc").Apply()).
+		Set("trashed", qp.Trashed, validation.AddRule().In("true", "false").Apply()).
+		Set("cursor", qp.Cursor, validation.AddRule().ByFunc(p.validCursor).Apply()).
+		Set("skip_total", qp.SkipTotal, validation.AddRule().In("true", "false").Apply()).
 		Set("search_condition", strings.TrimSpace(qp.SearchCondition), validation.AddRule().In("and", "or").Apply()).
-		Set("date_range_by", qp.DateRangeBy, validation.AddRule().IsLowerAlphaUnderscore().In(timeFields...).Apply()).
+		Set("date_range_by", qp.DateRangeBy, validation.AddRule().IsLowerAlphaUnderscore().In(fields.TimeFields()...).Apply()).
 		Set("date_start", qp.DateStart, validation.AddRule().IsDate("2006-01-02").Apply()).
 		Set("date_end", qp.DateEnd, validation.AddRule().IsDate("2006-01-02").Apply())
 
-	for _, querySlice := range qp.Equals {
-		for key, value := range querySlice {
-			validation.
-				Set("equal", key, validation.AddRule().IsLowerAlphaUnderscore().In(filterableFields...).Apply()).
-				Set(fmt.Sprintf("equal[%s]", key), value, validation.AddRule().IsAlphaNumericSpaceAndSpecialCharacter().Apply())
-		}
+	if qp.Sort == "" {
+		validation.
+			Set("order_by", qp.OrderBy, validation.AddRule().Required().In(fields.SortableFields()...).Apply()).
+			Set("order_method", qp.OrderMethod, validation.AddRule().Required().In("asc", "desc").Apply())
 	}
 
-	for _, querySlice := range qp.Likes {
-		for key, value := range querySlice {
-			validation.
-				Set("like", key, validation.AddRule().IsLowerAlphaUnderscore().In(filterableFields...).Apply()).
-				Set(fmt.Sprintf("like[%s]", key), value, validation.AddRule().IsAlphaNumericSpaceAndSpecialCharacter().Apply())
-		}
+	for _, field := range parseSort(qp.Sort) {
+		validation.Set("sort", strings.TrimPrefix(field, "-"), validation.AddRule().IsLowerAlphaUnderscore().In(fields.SortableFields()...).Apply())
 	}
 
-	for _, querySlice := range qp.NotEquals {
-		for key, value := range querySlice {
-			validation.
-				Set("not", key, validation.AddRule().IsAlpha().In(filterableFields...).Apply()).
-				Set(fmt.Sprintf("not[%s]", key), value, validation.AddRule().IsAlphaNumericSpaceAndSpecialCharacter().Apply())
+	for _, operator := range append([]string{operatorEqual, operatorLike, operatorNot}, filterOperators...) {
+		queryFilter := qp.Filters[operator]
+		switch operator {
+		case operatorEqual:
+			queryFilter = qp.Equals
+		case operatorLike:
+			queryFilter = qp.Likes
+		case operatorNot:
+			queryFilter = qp.NotEquals
+		}
+
+		for _, querySlice := range queryFilter {
+			for key, value := range querySlice {
+				setFilterRules(validation, fields, operator, key, value)
+			}
 		}
 	}
 
+	if qp.Filter != "" && qp.FilterExpression == nil {
+		validation.Set("filter", qp.Filter, validation.AddRule().ByFunc(invalidFilterExpression).Apply())
+	}
+
+	setFieldsRules(validation, p.Fields, fields)
+
+	for _, name := range qp.InvalidQueryStrings {
+		validation.Set(name, name, validation.AddRule().ByFunc(invalidQueryString).Apply())
+	}
+
+	if qp.FilterExpression != nil {
+		qp.FilterExpression.setRules(validation, fields, 1)
+	}
+
 	return validation.Validate()
 }
+
+// setFilterRules set the rules of the filter operator on the field, the field must be filterable by the operator
+// and the value must be valid for the type of the field.
+func setFilterRules(validation *validator.Validator, fields Fields, operator string, field string, value interface{}) {
+	allowedFields := fields.FilterableFields()
+	switch operator {
+	case operatorGt, operatorGte, operatorLt, operatorLte:
+		allowedFields = fieldsOfTypes(fields, FieldTypeNumber, FieldTypeTime)
+	case operatorLike, operatorStartsWith, operatorIEqual, operatorILike:
+		allowedFields = fieldsOfTypes(fields, FieldTypeString)
+	}
+
+	validation.Set(operator, field, validation.AddRule().Required().IsLowerAlphaUnderscore().In(allowedFields...).Apply())
+
+	fieldType := fields.FilterableFieldTypes()[field]
+	filterKey := fmt.Sprintf("%s[%s]", operator, field)
+	switch operator {
+	case operatorEqual, operatorNot:
+		validation.Set(filterKey, value, filterValueRule(validation, fieldType).Apply())
+	case operatorLike:
+		validation.Set(filterKey, value, validation.AddRule().IsAlphaNumericSpaceAndSpecialCharacter().Apply())
+	case operatorIsNull:
+		validation.Set(filterKey, value, validation.AddRule().Required().In("true", "false").Apply())
+	case operatorIn:
+		s, _ := value.(string)
+		values := splitInValues(s)
+		validation.Set(filterKey, len(values), validation.AddRule().Between(1, maxInValues).Apply())
+		for _, v := range values {
+			validation.Set(filterKey, v, filterValueRule(validation, fieldType).Apply())
+		}
+	case operatorGt, operatorGte, operatorLt, operatorLte, operatorStartsWith, operatorIEqual, operatorILike:
+		validation.Set(filterKey, value, filterValueRule(validation, fieldType).Required().Apply())
+	default:
+		validation.Set("filter", operator, validation.AddRule().ByFunc(invalidFilterExpression).Apply())
+	}
+}
+
+// filterValueRule return the rule of the filter value based on the type of the field.
+func filterValueRule(validation *validator.Validator, fieldType FieldType) *validator.ValidationRules {
+	if fieldType == "" || fieldType == FieldTypeString {
+		return validation.AddRule().IsAlphaNumericSpaceAndSpecialCharacter()
+	}
+
+	return validation.AddRule().ByFunc(validFieldValue(fieldType))
+}
+
+// invalidQueryString is a closure uses to reject the query string parameter which cannot be parsed.
+func invalidQueryString(interface{}) error {
+	return errors.New("validation.error.must_be_valid_query_string")
+}
+
+// validCursor is a closure uses to validate the cursor parameter, an invalid cursor is not decoded while building the parameters.
+func (p *SQLQueryParameters) validCursor(value interface{}) error {
+	cursor, _ := value.(string)
+	if cursor != "" && p.Cursor == nil {
+		return errors.New("validation.error.must_be_valid_cursor")
+	}
+
+	return nil
+}
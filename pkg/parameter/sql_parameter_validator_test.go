@@ -1,7 +1,85 @@
 package parameter_test
 
-import "testing"
+import (
+	"micro/pkg/parameter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fields struct{}
+
+func (f *fields) FilterableFields() []interface{} {
+	return []interface{}{"name", "size", "created_at"}
+}
+
+func (f *fields) FilterableFieldTypes() map[string]parameter.FieldType {
+	return map[string]parameter.FieldType{"size": parameter.FieldTypeNumber, "created_at": parameter.FieldTypeTime}
+}
+
+func (f *fields) SortableFields() []interface{} {
+	return []interface{}{"name", "created_at"}
+}
+
+func (f *fields) TimeFields() []interface{} {
+	return []interface{}{"created_at"}
+}
+
+func (f *fields) SelectableFields() map[string][]string {
+	return map[string][]string{"id": {"id"}, "name": {"name"}, "desc": {"description"}}
+}
 
 func TestParameterValidateParameter(t *testing.T) {
-	// TODO TestParameterValidateParameter
+	sourceParameters := parameter.SourceParameters{
+		Page:        1,
+		PerPage:     10,
+		OrderBy:     "created_at",
+		OrderMethod: "desc",
+		Trashed:     "false",
+		SkipTotal:   "false",
+		QueryStrings: map[string][]string{
+			"in[name]":          {"a,b"},
+			"gte[size]":         {"1024"},
+			"lt[created_at]":    {"2021-12-31"},
+			"is_null[name]":     {"false"},
+			"starts_with[name]": {"abc"},
+		},
+	}
+	assert.Empty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.QueryStrings = map[string][]string{"gt[name]": {"abc"}}
+	assert.NotEmpty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.QueryStrings = map[string][]string{"gt[size]": {"abc"}}
+	assert.NotEmpty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.QueryStrings = map[string][]string{"starts_with[size]": {"1"}}
+	assert.NotEmpty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.QueryStrings = map[string][]string{"is_null[name]": {"yes"}}
+	assert.NotEmpty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.QueryStrings = nil
+	sourceParameters.Sort = "-created_at,size"
+	assert.NotEmpty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.Sort = ""
+	sourceParameters.Filter = `{"or":[{"field":"name","op":"starts_with","value":"a"},{"and":[{"field":"size","op":"gte","value":"1"},{"field":"created_at","op":"is_null","value":"false"}]}]}`
+	assert.Empty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.Filter = `{"or":[{"field":"slug","op":"equal","value":"a"}]}`
+	assert.NotEmpty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.Filter = `{"or":[{"field":"name","op":"drop","value":"a"}]}`
+	assert.NotEmpty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.Filter = `{"or":[{"field":"name"`
+	assert.NotEmpty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.Filter = ""
+	sourceParameters.Fields = "id,desc"
+	assert.Empty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
+
+	sourceParameters.Fields = "id,password"
+	assert.NotEmpty(t, sourceParameters.BuildParameter().ValidateParameter(&fields{}))
 }
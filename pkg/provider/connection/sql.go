@@ -1,20 +1,15 @@
 package connection
 
 import (
-	"errors"
-	"fmt"
+	"database/sql"
 	"micro/pkg/configurator"
 	"os"
 	"strings"
 	"time"
 
-	"github.com/jackc/pgx/v4/stdlib"
-	"github.com/lib/pq"
-
 	consoleLog "log"
 
 	"gorm.io/driver/mysql"
-	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
 	sqlTracer "gopkg.in/DataDog/dd-trace-go.v1/contrib/database/sql"
@@ -24,251 +19,178 @@ import (
 const (
 	driverMysql    = "mysql"
 	driverPostgres = "postgres"
+	driverSQLite   = "sqlite"
 )
 
+// slowQueryThreshold is the duration from which the query is logged as slow query.
+const slowQueryThreshold = time.Second
+
 // DSNCollection holds DSN string.
 type DSNCollection struct {
-	driver string
-	dsn    string
+	driver     *SQLDriver
+	connConfig DBConnectionConfig
+	dsn        string
 }
 
 // DSNCollections holds multiple DSN.
 type DSNCollections []DSNCollection
 
-// ToGormDialects transform into multiple gorm dialects.
-func (d DSNCollections) ToGormDialects() []gorm.Dialector {
+// ToGormDialects transform into multiple gorm dialects, the connections are opened through the Datadog tracer the
+// same way as the primary database when the tracer is enabled.
+func (d DSNCollections) ToGormDialects(config *configurator.Config) ([]gorm.Dialector, error) {
 	var gormDialects []gorm.Dialector
 	for _, dsn := range d {
-		if dsn.driver == driverPostgres {
-			gormDialects = append(gormDialects, postgres.Open(dsn.dsn))
+		dialector, err := newDialector(dsn.driver, dsn.connConfig, config)
+		if err != nil {
+			return nil, err
 		}
 
-		if dsn.driver == driverMysql {
-			gormDialects = append(gormDialects, mysql.Open(dsn.dsn))
+		// The mysql dialector queries the version on initialize, which fails the start of the service when a
+		// replica is unreachable, so the version is not queried for the replicas.
+		if mysqlDialector, ok := dialector.(*mysql.Dialector); ok {
+			mysqlDialector.SkipInitializeWithVersion = true
 		}
+
+		gormDialects = append(gormDialects, dialector)
 	}
 
-	return gormDialects
+	return gormDialects, nil
 }
 
 // NewDSN is a function uses to construct DSN for the sql database connection.
 func NewDSN(config *configurator.Config) string {
-	var driver string
-	if config.TestMode == false {
-		driver = config.DBConfig.DBDriver
-	} else {
-		driver = config.DBTestConfig.DBDriver
-	}
-	switch driver {
-	case driverPostgres:
-		if config.TestMode == false {
-			dsn := &PostgresDSN{
-				Host:     config.DBConfig.DBHost,
-				Port:     config.DBConfig.DBPort,
-				User:     config.DBConfig.DBUser,
-				Password: config.DBConfig.DBPassword,
-				DBName:   config.DBConfig.DBName,
-				SSLMode:  false,
-				Timezone: config.DBConfig.DBTimeZone,
-			}
-
-			return dsn.ToString()
-		}
-
-		dsn := &PostgresDSN{
-			Host:     config.DBTestConfig.DBHost,
-			Port:     config.DBTestConfig.DBPort,
-			User:     config.DBTestConfig.DBUser,
-			Password: config.DBTestConfig.DBPassword,
-			DBName:   config.DBTestConfig.DBName,
-			SSLMode:  false,
-			Timezone: config.DBTestConfig.DBTimeZone,
-		}
+	connConfig := newDBConnectionConfig(config)
 
-		return dsn.ToString()
-	case driverMysql:
-		if config.TestMode == false {
-			dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8&parseTime=True&loc=Local",
-				config.DBConfig.DBUser,
-				config.DBConfig.DBPassword,
-				config.DBConfig.DBHost,
-				config.DBConfig.DBPort,
-				config.DBConfig.DBName,
-			)
-			return dsn
-		}
-
-		dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8&parseTime=True&loc=Local",
-			config.DBTestConfig.DBUser,
-			config.DBTestConfig.DBPassword,
-			config.DBTestConfig.DBHost,
-			config.DBTestConfig.DBPort,
-			config.DBTestConfig.DBName,
-		)
-		return dsn
-	default:
+	sqlDriver, err := LookupSQLDriver(connConfig.Driver)
+	if err != nil {
 		return ""
 	}
+
+	return sqlDriver.DSN(connConfig)
 }
 
 // NewReplicaDSNCollections is a function uses to construct multiple DSN for the sql database connection.
+// The replica of the driver which is not registered is skipped.
 func NewReplicaDSNCollections(configs configurator.DBReplicasConfig) DSNCollections {
 	var dsnCollection DSNCollections
 	for _, config := range configs {
-		driver := config.DBDriver
-		switch driver {
-		case driverPostgres:
-			dsn := &PostgresDSN{
-				Host:     config.DBHost,
-				Port:     config.DBPort,
-				User:     config.DBUser,
-				Password: config.DBPassword,
-				DBName:   config.DBName,
-				SSLMode:  false,
-				Timezone: config.DBTimeZone,
-			}
-
-			dsnCollection = append(dsnCollection, DSNCollection{
-				driver: driverPostgres,
-				dsn:    dsn.ToString(),
-			})
-		case driverMysql:
-			dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8&parseTime=True&loc=Local",
-				config.DBUser,
-				config.DBPassword,
-				config.DBHost,
-				config.DBPort,
-				config.DBName,
-			)
-
-			dsnCollection = append(dsnCollection, DSNCollection{
-				driver: driverPostgres,
-				dsn:    dsn,
-			})
+		connConfig := newReplicaConnectionConfig(config)
+
+		sqlDriver, err := LookupSQLDriver(connConfig.Driver)
+		if err != nil {
+			continue
 		}
+
+		dsnCollection = append(dsnCollection, DSNCollection{
+			driver:     sqlDriver,
+			connConfig: connConfig,
+			dsn:        sqlDriver.DSN(connConfig),
+		})
 	}
 
 	return dsnCollection
 }
 
 // NewDBConnection is a constructor will initialize sql database connection.
-//gocyclo:ignore
+// The tracing, the logging of the slow queries and the connection pool are set the same way for every driver.
 func NewDBConnection(config *configurator.Config) (*gorm.DB, error) {
-	newLogger := dbLogger.New(
-		consoleLog.New(os.Stdout, "\r\n", consoleLog.LstdFlags),
-		dbLogger.Config{
-			SlowThreshold: time.Second,
-			LogLevel:      dbLogger.Info,
-			Colorful:      true,
-		},
-	)
+	connConfig := newDBConnectionConfig(config)
 
-	var disableFKConstraint bool
-	if config.TestMode == false {
-		disableFKConstraint = config.DBConfig.DisableForeignKeyConstraint
-	} else {
-		disableFKConstraint = config.DBTestConfig.DisableForeignKeyConstraint
+	sqlDriver, err := LookupSQLDriver(connConfig.Driver)
+	if err != nil {
+		return nil, err
 	}
 
-	gormConfig := &gorm.Config{
-		DisableForeignKeyConstraintWhenMigrating: disableFKConstraint,
-		PrepareStmt:                              config.EnableCachePrepareStmt,
+	dialector, err := newDialector(sqlDriver, connConfig, config)
+	if err != nil {
+		return nil, err
 	}
 
-	if config.DBConfig.DBLog {
-		gormConfig.Logger = newLogger
+	gormConfig := &gorm.Config{
+		DisableForeignKeyConstraintWhenMigrating: connConfig.DisableForeignKeyConstraint,
+		PrepareStmt:                              config.EnableCachePrepareStmt,
+		Logger:                                   newDBLogger(consoleLog.New(os.Stdout, "\r\n", consoleLog.LstdFlags), connConfig.Log),
 	}
 
-	var driver string
-	if config.TestMode == false {
-		driver = config.DBConfig.DBDriver
-	} else {
-		driver = config.DBTestConfig.DBDriver
+	db, err := gorm.Open(dialector, gormConfig)
+	if err != nil {
+		return nil, err
 	}
 
-	switch driver {
-	case driverPostgres:
-		if config.DataDogConfig.EnableTracer {
-			sqlTracer.Register("lib/pq", pq.Driver{},
-				sqlTracer.WithServiceName(strings.ReplaceAll(config.AppName, "-http", "")+"-db__"+config.DBConfig.DBName),
-				sqlTracer.WithDSN(config.DBConfig.DBName),
-				sqlTracer.WithAnalytics(true),
-			)
-			sqlDb, err := sqlTracer.Open("lib/pq", NewDSN(config))
-
-			db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDb}), gormConfig)
-			if err != nil {
-				return nil, err
-			}
-
-			db, err = setConnectionPool(db, config)
-			if err != nil {
-				return nil, err
-			}
-
-			return db, nil
-		}
-
-		db, err := gorm.Open(postgres.Open(NewDSN(config)), gormConfig)
-		if err != nil {
-			return nil, err
-		}
-
-		db, err = setConnectionPool(db, config)
-		if err != nil {
-			return nil, err
-		}
-
-		return db, nil
+	return setConnectionPool(db, config)
+}
 
-	case driverMysql:
-		if config.DataDogConfig.EnableTracer {
-			sqlTracer.Register("lib/pq", &stdlib.Driver{},
-				sqlTracer.WithServiceName(strings.ReplaceAll(config.AppName, "-http", "")+"-db__"+config.DBConfig.DBName),
-				sqlTracer.WithDSN(config.DBConfig.DBName),
-				sqlTracer.WithAnalytics(true),
-			)
-			sqlDb, err := sqlTracer.Open("lib/pq", NewDSN(config))
+// CloseDBConnection will stop the health check of the replicas and close the connections of the primary database.
+// It is called by the owner of the connection when the connection is not used anymore.
+func CloseDBConnection(db *gorm.DB) error {
+	if policy, ok := db.Config.Plugins[replicaPolicyName].(*replicaPolicy); ok {
+		policy.Close()
+	}
 
-			db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDb}), gormConfig)
-			if err != nil {
-				return nil, err
-			}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
 
-			db, err = setConnectionPool(db, config)
-			if err != nil {
-				return nil, err
-			}
+	return sqlDB.Close()
+}
 
-			return db, nil
-		}
+// newDialector return the gorm dialector of the driver, the connection is opened through the Datadog tracer
+// when the tracer is enabled.
+func newDialector(sqlDriver *SQLDriver, connConfig DBConnectionConfig, config *configurator.Config) (gorm.Dialector, error) {
+	dsn := sqlDriver.DSN(connConfig)
+	if !config.DataDogConfig.EnableTracer {
+		return sqlDriver.Dialector(dsn, nil), nil
+	}
 
-		db, err := gorm.Open(mysql.Open(NewDSN(config)), gormConfig)
-		if err != nil {
-			return nil, err
-		}
+	sqlTracer.Register(sqlDriver.SQLDriverName, sqlDriver.SQLDriver(), sqlTracer.WithAnalytics(true))
 
-		db, err = setConnectionPool(db, config)
-		if err != nil {
-			return nil, err
-		}
+	sqlDB, err := sqlTracer.Open(sqlDriver.SQLDriverName, dsn,
+		sqlTracer.WithServiceName(strings.ReplaceAll(config.AppName, "-http", "")+"-db__"+connConfig.Name),
+		sqlTracer.WithDSN(connConfig.Name),
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		return db, nil
+	return sqlDriver.Dialector(dsn, sqlDB), nil
+}
 
-	default:
-		return nil, errors.New("common.error.database.driver.not_defined")
+// newDBLogger return the logger of the queries, the slow queries are always logged and every query is logged
+// when the log of the database is enabled.
+func newDBLogger(writer dbLogger.Writer, logQueries bool) dbLogger.Interface {
+	logLevel := dbLogger.Warn
+	if logQueries {
+		logLevel = dbLogger.Info
 	}
+
+	return dbLogger.New(writer, dbLogger.Config{
+		SlowThreshold: slowQueryThreshold,
+		LogLevel:      logLevel,
+		Colorful:      true,
+	})
 }
 
+// setConnectionPool will set the connection pool of the db and register the replicas.
 func setConnectionPool(db *gorm.DB, config *configurator.Config) (*gorm.DB, error) {
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, err
 	}
 
+	applyConnectionPool(sqlDB, config)
+
+	err = registerReplicas(db, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// applyConnectionPool will set the limits of the connection pool.
+func applyConnectionPool(sqlDB *sql.DB, config *configurator.Config) {
 	sqlDB.SetMaxIdleConns(config.MaxIdleCons)
 	sqlDB.SetMaxOpenConns(config.MaxOpenCons)
 	sqlDB.SetConnMaxLifetime(time.Hour)
-
-	return db, nil
 }
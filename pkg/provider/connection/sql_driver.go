@@ -0,0 +1,166 @@
+package connection
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"micro/pkg/configurator"
+	"sync"
+
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v4/stdlib"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrDriverNotDefined is returned when the configured driver is not registered.
+	ErrDriverNotDefined = errors.New("common.error.database.driver.not_defined")
+	// ErrSQLiteNotCompiled is returned when the sqlite driver is configured on the binary built without sqlite tag.
+	ErrSQLiteNotCompiled = errors.New("common.error.database.driver.sqlite_not_compiled")
+)
+
+// DBConnectionConfig is the config of a sql database connection, it is taken from DBConfig, DBTestConfig or
+// DBReplicaConfig.
+type DBConnectionConfig struct {
+	Driver                      string
+	Host                        string
+	Port                        string
+	User                        string
+	Name                        string
+	Password                    string
+	TimeZone                    string
+	Log                         bool
+	DisableForeignKeyConstraint bool
+}
+
+// SQLDriver describes how to connect to the sql database of a driver.
+type SQLDriver struct {
+	// Name is the name of the driver on the config, e.g. DB_DRIVER=mysql.
+	Name string
+	// SQLDriverName is the name of the database/sql driver, it is the name the driver is registered to the tracer.
+	SQLDriverName string
+	// SQLDriver return the database/sql driver which is wrapped by the tracer.
+	SQLDriver func() driver.Driver
+	// DSN build the DSN of the connection config.
+	DSN func(config DBConnectionConfig) string
+	// Dialector return the gorm dialector which opens the DSN, or uses the conn when it is not nil.
+	Dialector func(dsn string, conn gorm.ConnPool) gorm.Dialector
+}
+
+var (
+	sqlDriversMu sync.RWMutex
+	sqlDrivers   = make(map[string]*SQLDriver)
+)
+
+// RegisterSQLDriver is a function uses to register the driver by its name, the driver of the same name is replaced.
+func RegisterSQLDriver(sqlDriver *SQLDriver) {
+	sqlDriversMu.Lock()
+	defer sqlDriversMu.Unlock()
+
+	sqlDrivers[sqlDriver.Name] = sqlDriver
+}
+
+// LookupSQLDriver return the registered driver of the name.
+func LookupSQLDriver(name string) (*SQLDriver, error) {
+	sqlDriversMu.RLock()
+	defer sqlDriversMu.RUnlock()
+
+	sqlDriver, ok := sqlDrivers[name]
+	if !ok {
+		if name == driverSQLite {
+			return nil, ErrSQLiteNotCompiled
+		}
+
+		return nil, fmt.Errorf("%w: %q", ErrDriverNotDefined, name)
+	}
+
+	return sqlDriver, nil
+}
+
+func init() {
+	RegisterSQLDriver(&SQLDriver{
+		Name:          driverPostgres,
+		SQLDriverName: "pgx",
+		SQLDriver:     func() driver.Driver { return stdlib.GetDefaultDriver() },
+		DSN: func(config DBConnectionConfig) string {
+			dsn := &PostgresDSN{
+				Host:     config.Host,
+				Port:     config.Port,
+				User:     config.User,
+				Password: config.Password,
+				DBName:   config.Name,
+				SSLMode:  false,
+				Timezone: config.TimeZone,
+			}
+
+			return dsn.ToString()
+		},
+		Dialector: func(dsn string, conn gorm.ConnPool) gorm.Dialector {
+			return postgres.New(postgres.Config{DSN: dsn, Conn: conn})
+		},
+	})
+
+	RegisterSQLDriver(&SQLDriver{
+		Name:          driverMysql,
+		SQLDriverName: "mysql",
+		SQLDriver:     func() driver.Driver { return &mysqlDriver.MySQLDriver{} },
+		DSN: func(config DBConnectionConfig) string {
+			return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8&parseTime=True&loc=Local",
+				config.User,
+				config.Password,
+				config.Host,
+				config.Port,
+				config.Name,
+			)
+		},
+		Dialector: func(dsn string, conn gorm.ConnPool) gorm.Dialector {
+			return mysql.New(mysql.Config{DSN: dsn, Conn: conn})
+		},
+	})
+}
+
+// newDBConnectionConfig return the connection config of the primary database, or of the test database on test mode.
+func newDBConnectionConfig(config *configurator.Config) DBConnectionConfig {
+	if config.TestMode == false {
+		return DBConnectionConfig{
+			Driver:                      config.DBConfig.DBDriver,
+			Host:                        config.DBConfig.DBHost,
+			Port:                        config.DBConfig.DBPort,
+			User:                        config.DBConfig.DBUser,
+			Name:                        config.DBConfig.DBName,
+			Password:                    config.DBConfig.DBPassword,
+			TimeZone:                    config.DBConfig.DBTimeZone,
+			Log:                         config.DBConfig.DBLog,
+			DisableForeignKeyConstraint: config.DBConfig.DisableForeignKeyConstraint,
+		}
+	}
+
+	return DBConnectionConfig{
+		Driver:                      config.DBTestConfig.DBDriver,
+		Host:                        config.DBTestConfig.DBHost,
+		Port:                        config.DBTestConfig.DBPort,
+		User:                        config.DBTestConfig.DBUser,
+		Name:                        config.DBTestConfig.DBName,
+		Password:                    config.DBTestConfig.DBPassword,
+		TimeZone:                    config.DBTestConfig.DBTimeZone,
+		Log:                         config.DBTestConfig.DBLog,
+		DisableForeignKeyConstraint: config.DBTestConfig.DisableForeignKeyConstraint,
+	}
+}
+
+// newReplicaConnectionConfig return the connection config of the replica.
+func newReplicaConnectionConfig(config *configurator.DBReplicaConfig) DBConnectionConfig {
+	return DBConnectionConfig{
+		Driver:                      config.DBDriver,
+		Host:                        config.DBHost,
+		Port:                        config.DBPort,
+		User:                        config.DBUser,
+		Name:                        config.DBName,
+		Password:                    config.DBPassword,
+		TimeZone:                    config.DBTimeZone,
+		Log:                         config.DBLog,
+		DisableForeignKeyConstraint: config.DisableForeignKeyConstraint,
+	}
+}
@@ -0,0 +1,179 @@
+package connection
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"micro/pkg/configurator"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/mocktracer"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type testLogWriter struct {
+	lines []string
+}
+
+func (w *testLogWriter) Printf(format string, args ...interface{}) {
+	w.lines = append(w.lines, fmt.Sprintf(format, args...))
+}
+
+func newTestSQLConfig(driver string, enableTracer bool) *configurator.Config {
+	config := &configurator.Config{AppName: "micro-http", MaxIdleCons: 2, MaxOpenCons: 7}
+	config.DBConfig.DBDriver = driver
+	config.DBConfig.DBHost = "127.0.0.1"
+	config.DBConfig.DBPort = "1"
+	config.DBConfig.DBUser = "micro"
+	config.DBConfig.DBName = "micro"
+	config.DataDogConfig.EnableTracer = enableTracer
+
+	return config
+}
+
+func dialectorConn(dialector gorm.Dialector) gorm.ConnPool {
+	switch dialector := dialector.(type) {
+	case *postgres.Dialector:
+		return dialector.Conn
+	case *mysql.Dialector:
+		return dialector.Conn
+	default:
+		return nil
+	}
+}
+
+func TestSQLDriverLookup(t *testing.T) {
+	for _, name := range []string{driverPostgres, driverMysql, driverSQLite} {
+		sqlDriver, err := LookupSQLDriver(name)
+		assert.NoError(t, err)
+		assert.Equal(t, name, sqlDriver.Name)
+	}
+
+	_, err := LookupSQLDriver("oracle")
+	assert.True(t, errors.Is(err, ErrDriverNotDefined))
+
+	_, err = NewDBConnection(newTestSQLConfig("oracle", false))
+	assert.True(t, errors.Is(err, ErrDriverNotDefined))
+	assert.Empty(t, NewDSN(newTestSQLConfig("oracle", false)))
+}
+
+func TestSQLDriverDSN(t *testing.T) {
+	config := newTestSQLConfig(driverMysql, false)
+	assert.Equal(t, "micro:@tcp(127.0.0.1:1)/micro?charset=utf8&parseTime=True&loc=Local", NewDSN(config))
+
+	config.TestMode = true
+	config.DBTestConfig.DBDriver = driverPostgres
+	config.DBTestConfig.DBHost = "localhost"
+	config.DBTestConfig.DBPort = "5432"
+	config.DBTestConfig.DBName = "micro_test"
+	assert.Equal(t, (&PostgresDSN{Host: "localhost", Port: "5432", DBName: "micro_test"}).ToString(), NewDSN(config))
+}
+
+func TestSQLDriverDialectorWithTracer(t *testing.T) {
+	mt := mocktracer.Start()
+	defer mt.Stop()
+
+	testCases := []struct {
+		driver       string
+		enableTracer bool
+		dialector    gorm.Dialector
+	}{
+		{driver: driverPostgres, enableTracer: false, dialector: &postgres.Dialector{}},
+		{driver: driverPostgres, enableTracer: true, dialector: &postgres.Dialector{}},
+		{driver: driverMysql, enableTracer: false, dialector: &mysql.Dialector{}},
+		{driver: driverMysql, enableTracer: true, dialector: &mysql.Dialector{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s/tracer=%t", tc.driver, tc.enableTracer), func(t *testing.T) {
+			mt.Reset()
+
+			config := newTestSQLConfig(tc.driver, tc.enableTracer)
+			sqlDriver, err := LookupSQLDriver(tc.driver)
+			assert.NoError(t, err)
+
+			dialector, err := newDialector(sqlDriver, newDBConnectionConfig(config), config)
+			assert.NoError(t, err)
+			assert.IsType(t, tc.dialector, dialector)
+
+			conn := dialectorConn(dialector)
+			if !tc.enableTracer {
+				assert.Nil(t, conn)
+				return
+			}
+
+			sqlDB, ok := conn.(*sql.DB)
+			assert.True(t, ok)
+			defer sqlDB.Close()
+
+			// Nothing listens on the port, the failed connect is traced by the driver of the registry.
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			assert.Error(t, sqlDB.PingContext(ctx))
+
+			spans := mt.FinishedSpans()
+			assert.NotEmpty(t, spans)
+			for _, span := range spans {
+				assert.Equal(t, sqlDriver.SQLDriverName+".query", span.OperationName())
+				assert.Equal(t, "micro-db__micro", span.Tag("service.name"))
+			}
+		})
+	}
+}
+
+func TestSQLDriverSQLiteConnection(t *testing.T) {
+	config := newTestSQLConfig(driverSQLite, false)
+	config.DBConfig.DBName = ":memory:"
+
+	db, err := NewDBConnection(config)
+	assert.NoError(t, err)
+	assert.Equal(t, driverSQLite, db.Dialector.Name())
+
+	var result int
+	assert.NoError(t, db.Raw("SELECT 1").Scan(&result).Error)
+	assert.Equal(t, 1, result)
+
+	sqlDB, err := db.DB()
+	assert.NoError(t, err)
+	assert.NoError(t, sqlDB.Close())
+}
+
+func TestSQLDriverConnectionPool(t *testing.T) {
+	for _, driver := range []string{driverPostgres, driverMysql} {
+		config := newTestSQLConfig(driver, false)
+		sqlDriver, err := LookupSQLDriver(driver)
+		assert.NoError(t, err)
+
+		sqlDB, err := sql.Open(sqlDriver.SQLDriverName, sqlDriver.DSN(newDBConnectionConfig(config)))
+		assert.NoError(t, err)
+
+		applyConnectionPool(sqlDB, config)
+		assert.Equal(t, config.MaxOpenCons, sqlDB.Stats().MaxOpenConnections)
+		assert.NoError(t, sqlDB.Close())
+	}
+}
+
+func TestSQLDriverDBLogger(t *testing.T) {
+	ctx := context.Background()
+	query := func() (string, int64) { return "SELECT 1", 1 }
+
+	writer := &testLogWriter{}
+	logger := newDBLogger(writer, false)
+	logger.Trace(ctx, time.Now(), query, nil)
+	assert.Empty(t, writer.lines)
+
+	logger.Trace(ctx, time.Now().Add(-2*slowQueryThreshold), query, nil)
+	assert.Len(t, writer.lines, 1)
+	assert.Contains(t, writer.lines[0], "SLOW SQL")
+
+	writer = &testLogWriter{}
+	logger = newDBLogger(writer, true)
+	logger.Trace(ctx, time.Now(), query, nil)
+	assert.Len(t, writer.lines, 1)
+	assert.Contains(t, writer.lines[0], "SELECT 1")
+}
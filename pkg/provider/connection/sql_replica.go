@@ -0,0 +1,208 @@
+package connection
+
+import (
+	"context"
+	"micro/pkg/configurator"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+const (
+	replicaPingTimeout = time.Second
+	// replicaHealthCheckInterval is the interval of the replica health check when none is configured.
+	replicaHealthCheckInterval = 10 * time.Second
+	// replicaPolicyName is the name of the replicaPolicy plugin, so CloseDBConnection finds the policy of the db.
+	replicaPolicyName = "micro:replica_policy"
+)
+
+type primaryContextKey struct{}
+
+// WithPrimary return a copy of ctx which forces the reads to the primary database, e.g. to read your own writes.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryContextKey{}, true)
+}
+
+// IsPrimaryForced return true when the reads of ctx are forced to the primary database.
+func IsPrimaryForced(ctx context.Context) bool {
+	forced, _ := ctx.Value(primaryContextKey{}).(bool)
+
+	return forced
+}
+
+// registerReplicas will register the replicas of the config to the db, so the reads are sent to the replicas and
+// the writes and the transactions are sent to the primary.
+func registerReplicas(db *gorm.DB, config *configurator.Config) error {
+	if config.TestMode || len(config.DBReplicasConfig) == 0 {
+		return nil
+	}
+
+	// The replicas are opened without ping, so an unreachable replica is skipped by the policy instead of
+	// failing the start of the service.
+	db.Config.DisableAutomaticPing = true
+	defer func() { db.Config.DisableAutomaticPing = false }()
+
+	replicas, err := NewReplicaDSNCollections(config.DBReplicasConfig).ToGormDialects(config)
+	if err != nil {
+		return err
+	}
+
+	policy := newReplicaPolicy(db.Config.ConnPool, config.DBReplicaHealthCheckInterval)
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   policy,
+	}).
+		SetMaxIdleConns(config.MaxIdleCons).
+		SetMaxOpenConns(config.MaxOpenCons).
+		SetConnMaxLifetime(time.Hour)
+
+	err = db.Use(resolver)
+	if err != nil {
+		return err
+	}
+
+	err = db.Use(policy)
+	if err != nil {
+		return err
+	}
+
+	forcePrimary := func(tx *gorm.DB) {
+		if tx.Statement.Context != nil && IsPrimaryForced(tx.Statement.Context) {
+			dbresolver.Write.ModifyStatement(tx.Statement)
+		}
+	}
+
+	// The resolver is registered before any other callback, gorm cannot sort a callback before it by its name, so
+	// forcePrimary is registered before any other callback too, after the resolver, so it is run before the resolver.
+	err = db.Callback().Query().Before("*").Register("micro:force_primary", forcePrimary)
+	if err != nil {
+		return err
+	}
+
+	return db.Callback().Row().Before("*").Register("micro:force_primary", forcePrimary)
+}
+
+// replicaPolicy is a round-robin dbresolver.Policy which skips the unhealthy replicas.
+// The health of the replicas is checked by ping in background once per interval, so the reads never wait for
+// the ping, and the primary is used when no replica is healthy. The health check goes on until Close is called.
+type replicaPolicy struct {
+	primary  gorm.ConnPool
+	interval time.Duration
+	next     uint64
+
+	watchOnce sync.Once
+	closeOnce sync.Once
+	done      chan struct{}
+	mu        sync.RWMutex
+	health    map[gorm.ConnPool]bool
+}
+
+// newReplicaPolicy will initialize a new replicaPolicy.
+func newReplicaPolicy(primary gorm.ConnPool, interval time.Duration) *replicaPolicy {
+	if interval <= 0 {
+		interval = replicaHealthCheckInterval
+	}
+
+	return &replicaPolicy{
+		primary:  primary,
+		interval: interval,
+		done:     make(chan struct{}),
+		health:   make(map[gorm.ConnPool]bool),
+	}
+}
+
+// Name return the name of the plugin.
+func (p *replicaPolicy) Name() string {
+	return replicaPolicyName
+}
+
+// Initialize is a no-op, the policy is registered as a plugin only to be found by CloseDBConnection.
+func (p *replicaPolicy) Initialize(*gorm.DB) error {
+	return nil
+}
+
+// Close will stop the health check of the replicas, it is safe to call more than once.
+func (p *replicaPolicy) Close() {
+	p.closeOnce.Do(func() {
+		close(p.done)
+	})
+}
+
+// Resolve return the next healthy replica or the primary when no replica is healthy.
+// The replicas are checked once on the first call, then the health check goes on in background.
+func (p *replicaPolicy) Resolve(connPools []gorm.ConnPool) gorm.ConnPool {
+	p.watchOnce.Do(func() {
+		p.check(connPools)
+		go p.watch(connPools)
+	})
+
+	start := int(atomic.AddUint64(&p.next, 1) % uint64(len(connPools)))
+	for i := range connPools {
+		connPool := connPools[(start+i)%len(connPools)]
+		if p.isHealthy(connPool) {
+			return connPool
+		}
+	}
+
+	return p.primary
+}
+
+// isHealthy return the health of the replica from the last check.
+func (p *replicaPolicy) isHealthy(connPool gorm.ConnPool) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.health[connPool]
+}
+
+// watch will check the health of the replicas once per interval until the policy is closed.
+func (p *replicaPolicy) watch(connPools []gorm.ConnPool) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			p.check(connPools)
+		}
+	}
+}
+
+// check will ping the replicas concurrently and store their health.
+func (p *replicaPolicy) check(connPools []gorm.ConnPool) {
+	var wg sync.WaitGroup
+	for _, connPool := range connPools {
+		wg.Add(1)
+		go func(connPool gorm.ConnPool) {
+			defer wg.Done()
+
+			healthy := pingReplica(connPool)
+
+			p.mu.Lock()
+			p.health[connPool] = healthy
+			p.mu.Unlock()
+		}(connPool)
+	}
+
+	wg.Wait()
+}
+
+// pingReplica return true when the replica responds to ping before the timeout.
+func pingReplica(connPool gorm.ConnPool) bool {
+	pinger, ok := connPool.(interface {
+		PingContext(ctx context.Context) error
+	})
+	if !ok {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replicaPingTimeout)
+	defer cancel()
+
+	return pinger.PingContext(ctx) == nil
+}
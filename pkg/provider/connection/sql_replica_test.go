@@ -0,0 +1,214 @@
+package connection
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"micro/pkg/configurator"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/mocktracer"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// testReplica is a gorm.ConnPool which responds to ping with its err.
+type testReplica struct {
+	gorm.ConnPool
+	name  string
+	err   atomic.Value
+	pings int32
+}
+
+func newTestReplica(name string, err error) *testReplica {
+	replica := &testReplica{name: name}
+	replica.setErr(err)
+
+	return replica
+}
+
+func (r *testReplica) setErr(err error) {
+	r.err.Store(&err)
+}
+
+func (r *testReplica) PingContext(ctx context.Context) error {
+	atomic.AddInt32(&r.pings, 1)
+
+	return *r.err.Load().(*error)
+}
+
+func TestConnectionWithPrimary(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, IsPrimaryForced(ctx))
+	assert.True(t, IsPrimaryForced(WithPrimary(ctx)))
+}
+
+func TestConnectionNewReplicaDSNCollections(t *testing.T) {
+	dialects, err := NewReplicaDSNCollections(configurator.DBReplicasConfig{
+		{DBDriver: "postgres", DBHost: "localhost", DBPort: "5432"},
+		{DBDriver: "mysql", DBHost: "localhost", DBPort: "3306"},
+	}).ToGormDialects(newTestSQLConfig(driverPostgres, false))
+	require.NoError(t, err)
+
+	assert.Len(t, dialects, 2)
+	assert.IsType(t, &postgres.Dialector{}, dialects[0])
+	assert.IsType(t, &mysql.Dialector{}, dialects[1])
+	assert.True(t, dialects[1].(*mysql.Dialector).SkipInitializeWithVersion)
+	assert.Nil(t, dialectorConn(dialects[0]))
+}
+
+func TestConnectionReplicaDialectsWithTracer(t *testing.T) {
+	mt := mocktracer.Start()
+	defer mt.Stop()
+
+	dialects, err := NewReplicaDSNCollections(configurator.DBReplicasConfig{
+		{DBDriver: "postgres", DBHost: "127.0.0.1", DBPort: "1", DBUser: "micro", DBName: "replica"},
+		{DBDriver: "mysql", DBHost: "127.0.0.1", DBPort: "1", DBUser: "micro", DBName: "replica"},
+	}).ToGormDialects(newTestSQLConfig(driverPostgres, true))
+	require.NoError(t, err)
+	require.Len(t, dialects, 2)
+
+	for _, dialector := range dialects {
+		mt.Reset()
+
+		sqlDB, ok := dialectorConn(dialector).(*sql.DB)
+		require.True(t, ok)
+
+		// Nothing listens on the port, the failed connect of the replica is traced as the primary.
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		assert.Error(t, sqlDB.PingContext(ctx))
+		cancel()
+		assert.NoError(t, sqlDB.Close())
+
+		spans := mt.FinishedSpans()
+		assert.NotEmpty(t, spans)
+		for _, span := range spans {
+			assert.Equal(t, "micro-db__replica", span.Tag("service.name"))
+		}
+	}
+}
+
+func TestReplicaPolicyResolve(t *testing.T) {
+	errDown := errors.New("connection refused")
+	primary := newTestReplica("primary", nil)
+
+	testCases := []struct {
+		name     string
+		replicas []*testReplica
+		expected []string
+	}{
+		{
+			name:     "round-robin on the healthy replicas",
+			replicas: []*testReplica{newTestReplica("a", nil), newTestReplica("b", nil)},
+			expected: []string{"b", "a", "b", "a"},
+		},
+		{
+			name:     "skip the unhealthy replica",
+			replicas: []*testReplica{newTestReplica("a", errDown), newTestReplica("b", nil)},
+			expected: []string{"b", "b", "b", "b"},
+		},
+		{
+			name:     "fall back to the primary when no replica is healthy",
+			replicas: []*testReplica{newTestReplica("a", errDown), newTestReplica("b", errDown)},
+			expected: []string{"primary", "primary", "primary", "primary"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := newReplicaPolicy(primary, time.Hour)
+			defer policy.Close()
+
+			var connPools []gorm.ConnPool
+			for _, replica := range tc.replicas {
+				connPools = append(connPools, replica)
+			}
+
+			var resolved []string
+			for range tc.expected {
+				resolved = append(resolved, policy.Resolve(connPools).(*testReplica).name)
+			}
+
+			assert.Equal(t, tc.expected, resolved)
+		})
+	}
+}
+
+func TestReplicaPolicyHealthCheck(t *testing.T) {
+	errDown := errors.New("connection refused")
+	primary := newTestReplica("primary", nil)
+	replica := newTestReplica("replica", errDown)
+	connPools := []gorm.ConnPool{replica}
+
+	policy := newReplicaPolicy(primary, time.Hour)
+	defer policy.Close()
+	assert.Equal(t, primary, policy.Resolve(connPools))
+	assert.Equal(t, primary, policy.Resolve(connPools))
+
+	// The replica is pinged once by the first call, the next calls read the health of the last check.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&replica.pings))
+
+	replica.setErr(nil)
+	policy.check(connPools)
+	assert.Equal(t, replica, policy.Resolve(connPools))
+
+	replica.setErr(errDown)
+	policy.check(connPools)
+	assert.Equal(t, primary, policy.Resolve(connPools))
+}
+
+func TestReplicaPolicyWatch(t *testing.T) {
+	primary := newTestReplica("primary", nil)
+	replica := newTestReplica("replica", errors.New("connection refused"))
+	connPools := []gorm.ConnPool{replica}
+
+	policy := newReplicaPolicy(primary, 10*time.Millisecond)
+	assert.Equal(t, primary, policy.Resolve(connPools))
+
+	replica.setErr(nil)
+	assert.Eventually(t, func() bool {
+		return policy.Resolve(connPools) == replica
+	}, time.Second, 10*time.Millisecond)
+
+	// The replica is not pinged anymore once the policy is closed.
+	policy.Close()
+	policy.Close()
+	time.Sleep(20 * time.Millisecond)
+	pings := atomic.LoadInt32(&replica.pings)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, pings, atomic.LoadInt32(&replica.pings))
+}
+
+func TestConnectionReplicas(t *testing.T) {
+	config := newTestSQLConfig(driverSQLite, false)
+	config.DBConfig.DBName = filepath.Join(t.TempDir(), "primary.db")
+	config.DBReplicasConfig = configurator.DBReplicasConfig{{DBDriver: driverSQLite, DBName: filepath.Join(t.TempDir(), "replica.db")}}
+
+	db, err := NewDBConnection(config)
+	require.NoError(t, err)
+
+	policy, ok := db.Config.Plugins[replicaPolicyName].(*replicaPolicy)
+	require.True(t, ok)
+
+	// The table is created on the primary only, so it is read only when the reads are forced to the primary.
+	require.NoError(t, db.Exec("CREATE TABLE items (id INTEGER)").Error)
+
+	var total int64
+	assert.Error(t, db.Table("items").Count(&total).Error)
+	assert.NoError(t, db.WithContext(WithPrimary(context.Background())).Table("items").Count(&total).Error)
+
+	assert.NoError(t, CloseDBConnection(db))
+	assert.Error(t, db.Exec("SELECT 1").Error)
+
+	select {
+	case <-policy.done:
+	default:
+		assert.Fail(t, "the health check of the replicas is not stopped")
+	}
+}
@@ -0,0 +1,34 @@
+package connection
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func init() {
+	RegisterSQLDriver(&SQLDriver{
+		Name:          driverSQLite,
+		SQLDriverName: sqlite.DriverName,
+		SQLDriver:     sqliteDriver,
+		DSN: func(config DBConnectionConfig) string {
+			dsn := &SQLiteDSN{DBName: config.Name}
+
+			return dsn.ToString()
+		},
+		Dialector: func(dsn string, conn gorm.ConnPool) gorm.Dialector {
+			return &sqlite.Dialector{DSN: dsn, Conn: conn}
+		},
+	})
+}
+
+// sqliteDriver return the database/sql driver which is registered by the pure-Go SQLite driver, the driver is not
+// exported so it is taken from a pool which is never connected.
+func sqliteDriver() driver.Driver {
+	db, _ := sql.Open(sqlite.DriverName, "")
+	defer db.Close()
+
+	return db.Driver()
+}
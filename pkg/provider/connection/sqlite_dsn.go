@@ -0,0 +1,36 @@
+package connection
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SQLiteDSN is a struct for SQLite database connection DSN configuration.
+// DBName is the path of the database file, or :memory: for a database which lives as long as the connection pool.
+type SQLiteDSN struct {
+	DBName      string
+	BusyTimeout int
+}
+
+// ToString export to DSN string format. The foreign keys are enforced on each connection, and the in-memory
+// database uses the shared cache so every connection of the pool sees the same database.
+func (dsn SQLiteDSN) ToString() string {
+	name := dsn.DBName
+	if name == "" || name == ":memory:" {
+		name = "file::memory:?cache=shared"
+	} else if !strings.HasPrefix(name, "file:") {
+		name = "file:" + name
+	}
+
+	separator := "?"
+	if strings.Contains(name, "?") {
+		separator = "&"
+	}
+
+	busyTimeout := dsn.BusyTimeout
+	if busyTimeout == 0 {
+		busyTimeout = 5000
+	}
+
+	return fmt.Sprintf("%s%s_pragma=foreign_keys(1)&_pragma=busy_timeout(%d)", name, separator, busyTimeout)
+}
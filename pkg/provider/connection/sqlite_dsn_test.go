@@ -0,0 +1,14 @@
+package connection_test
+
+import (
+	"micro/pkg/provider/connection"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLiteDSNToString(t *testing.T) {
+	assert.Equal(t, "file:micro_test.db?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", connection.SQLiteDSN{DBName: "micro_test.db"}.ToString())
+	assert.Equal(t, "file::memory:?cache=shared&_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", connection.SQLiteDSN{DBName: ":memory:"}.ToString())
+	assert.Equal(t, "file:micro_test.db?mode=rwc&_pragma=foreign_keys(1)&_pragma=busy_timeout(100)", connection.SQLiteDSN{DBName: "file:micro_test.db?mode=rwc", BusyTimeout: 100}.ToString())
+}
@@ -0,0 +1,20 @@
+package scheduler
+
+import "micro/pkg/logger"
+
+// Option return Scheduler with Option.
+type Option func(*Scheduler)
+
+// WithLogger is a function to set logger to the Option.
+func WithLogger(logger *logger.Logger) Option {
+	return func(s *Scheduler) {
+		s.logger = logger
+	}
+}
+
+// WithJobs is a function to set jobs to the Option.
+func WithJobs(jobs ...Job) Option {
+	return func(s *Scheduler) {
+		s.Jobs = append(s.Jobs, jobs...)
+	}
+}
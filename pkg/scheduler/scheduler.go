@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"micro/pkg/logger"
+)
+
+// ErrInvalidInterval is returned when a Job is started with an interval which is not positive.
+var ErrInvalidInterval = errors.New("scheduler job interval must be positive")
+
+// Job is a struct represent a task executed periodically by the Scheduler.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler is a struct holds the registered Job and runs each of them on its own interval.
+type Scheduler struct {
+	Jobs   []Job
+	logger *logger.Logger
+	wg     sync.WaitGroup
+}
+
+// New is a constructor will initialize Scheduler.
+func New(options ...Option) *Scheduler {
+	scheduler := &Scheduler{}
+
+	for _, opt := range options {
+		opt(scheduler)
+	}
+
+	return scheduler
+}
+
+// Register is a function uses to add Job to the Scheduler.
+func (s *Scheduler) Register(jobs ...Job) *Scheduler {
+	s.Jobs = append(s.Jobs, jobs...)
+
+	return s
+}
+
+// Start is a function uses to run every registered Job in background.
+// Each Job is executed immediately and then repeated on its interval until the ctx is done.
+// A Job never overlaps with itself, a tick is skipped while the previous run is still in progress.
+// Nothing is started when the interval of any Job is not positive.
+func (s *Scheduler) Start(ctx context.Context) error {
+	for _, job := range s.Jobs {
+		if job.Interval <= 0 {
+			return fmt.Errorf("%w: %s has interval %s", ErrInvalidInterval, job.Name, job.Interval)
+		}
+	}
+
+	for _, job := range s.Jobs {
+		s.wg.Add(1)
+		go s.run(ctx, job)
+	}
+
+	return nil
+}
+
+// Wait is a function uses to block until every running Job has been stopped.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	s.execute(ctx, job)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.execute(ctx, job)
+		}
+	}
+}
+
+func (s *Scheduler) execute(ctx context.Context, job Job) {
+	if s.logger != nil {
+		s.logger.Log.Infof("Scheduler is running job: %s", job.Name)
+	}
+
+	err := job.Run(ctx)
+	if err != nil && s.logger != nil {
+		s.logger.Log.Errorf("Scheduler job %s failed, err: %v", job.Name, err)
+	}
+}
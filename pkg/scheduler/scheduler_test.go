@@ -0,0 +1,82 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"micro/pkg/scheduler"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedulerRegister(t *testing.T) {
+	s := scheduler.New(scheduler.WithJobs(scheduler.Job{Name: "first"}))
+	s.Register(scheduler.Job{Name: "second"})
+
+	assert.Len(t, s.Jobs, 2)
+	assert.Equal(t, "first", s.Jobs[0].Name)
+	assert.Equal(t, "second", s.Jobs[1].Name)
+}
+
+func TestSchedulerStart(t *testing.T) {
+	t.Run("if the scheduler is started should run the job immediately and on each interval", func(t *testing.T) {
+		var counter int32
+		s := scheduler.New().Register(scheduler.Job{
+			Name:     "counter",
+			Interval: 10 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				atomic.AddInt32(&counter, 1)
+				return nil
+			},
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 55*time.Millisecond)
+		defer cancel()
+
+		assert.NoError(t, s.Start(ctx))
+		s.Wait()
+
+		assert.GreaterOrEqual(t, atomic.LoadInt32(&counter), int32(3))
+	})
+
+	t.Run("if the job returns an error should keep running on the next interval", func(t *testing.T) {
+		var counter int32
+		s := scheduler.New().Register(scheduler.Job{
+			Name:     "failing",
+			Interval: 10 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				atomic.AddInt32(&counter, 1)
+				return errors.New("failed")
+			},
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 35*time.Millisecond)
+		defer cancel()
+
+		assert.NoError(t, s.Start(ctx))
+		s.Wait()
+
+		assert.GreaterOrEqual(t, atomic.LoadInt32(&counter), int32(2))
+	})
+}
+
+func TestSchedulerStartInvalidInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		var counter int32
+		s := scheduler.New().Register(scheduler.Job{
+			Name:     "invalid",
+			Interval: interval,
+			Run: func(ctx context.Context) error {
+				atomic.AddInt32(&counter, 1)
+				return nil
+			},
+		})
+
+		err := s.Start(context.Background())
+		assert.True(t, errors.Is(err, scheduler.ErrInvalidInterval))
+		s.Wait()
+		assert.Equal(t, int32(0), atomic.LoadInt32(&counter))
+	}
+}
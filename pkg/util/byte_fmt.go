@@ -63,7 +63,7 @@ func ByteSize(bytes uint64) string {
 	case bytes >= BYTE:
 		unit = " B"
 	case bytes == 0:
-		return " 0B"
+		return "0 B"
 	}
 
 	result := strconv.FormatFloat(value, 'f', 1, 64)
@@ -123,3 +123,15 @@ func ToBytes(s string) (uint64, error) {
 		return 0, errInvalidByteQuantity
 	}
 }
+
+// ParseByteSize parses a string formatted by ByteSize, e.g. 10MB or 10 MB, or a plain number of bytes as bytes.
+func ParseByteSize(s string) (uint64, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), " ", "")
+
+	bytes, err := strconv.ParseUint(s, 10, 64)
+	if err == nil {
+		return bytes, nil
+	}
+
+	return ToBytes(s)
+}
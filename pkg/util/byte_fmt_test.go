@@ -0,0 +1,48 @@
+package util_test
+
+import (
+	"micro/pkg/util"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUtilByteSize(t *testing.T) {
+	assert.Equal(t, "0 B", util.ByteSize(0))
+	assert.Equal(t, "512 B", util.ByteSize(512))
+	assert.Equal(t, "10 MB", util.ByteSize(10*util.MEGABYTE))
+	assert.Equal(t, "1.5 GB", util.ByteSize(util.GIGABYTE+util.GIGABYTE/2))
+}
+
+func TestUtilParseByteSize(t *testing.T) {
+	t.Run("if the given string is a human readable size should return the size in bytes", func(t *testing.T) {
+		for input, expected := range map[string]uint64{
+			"10MB":    10 * util.MEGABYTE,
+			"10 MB":   10 * util.MEGABYTE,
+			"1.5gb":   util.GIGABYTE + util.GIGABYTE/2,
+			"512K":    512 * util.KILOBYTE,
+			"100 B":   100,
+			"1048576": util.MEGABYTE,
+		} {
+			bytes, err := util.ParseByteSize(input)
+
+			assert.NoError(t, err, input)
+			assert.Equal(t, expected, bytes, input)
+		}
+	})
+
+	t.Run("if the given string is formatted by ByteSize should return the same size in bytes", func(t *testing.T) {
+		bytes, err := util.ParseByteSize(util.ByteSize(10 * util.MEGABYTE))
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(10*util.MEGABYTE), bytes)
+	})
+
+	t.Run("if the given string is not a valid size should return an error", func(t *testing.T) {
+		for _, input := range []string{"", "MB", "-10MB", "10XB", "ten"} {
+			_, err := util.ParseByteSize(input)
+
+			assert.Error(t, err, input)
+		}
+	})
+}
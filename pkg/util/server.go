@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"micro/pkg/logger"
+	"micro/pkg/scheduler"
 )
 
 // RunGRPCServerWithGracefulShutdown knows how to run and gracefully shutdown the grpc.Server.
@@ -106,3 +107,31 @@ func RunHTTPServerWithGracefulShutdown(handler http.Handler, addr string, shutdo
 
 	return nil
 }
+
+// RunSchedulerWithGracefulShutdown knows how to run and gracefully shutdown the scheduler.Scheduler.
+func RunSchedulerWithGracefulShutdown(s *scheduler.Scheduler, logStd *logger.Logger) error {
+	logStd.Log.Info("Scheduler is starting ...")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := s.Start(ctx)
+	if err != nil {
+		return err
+	}
+	logStd.Log.Infof("Scheduler is running with %d jobs", len(s.Jobs))
+
+	// Make a channel to listen for an interrupt or terminate signal from the OS.
+	// Use a buffered channel because the signal package requires it.
+	shutdownListenerChannel := make(chan os.Signal, 1)
+	signal.Notify(shutdownListenerChannel, syscall.SIGINT, syscall.SIGTERM)
+
+	// Blocking and waiting for shutdown, then wait for the running jobs to be finished.
+	sig := <-shutdownListenerChannel
+	logStd.Log.Infof("Scheduler shutdown by signal: %v", sig)
+	cancel()
+	s.Wait()
+	logStd.Log.Info("Scheduler was shutting down gracefully")
+
+	return nil
+}
@@ -38,6 +38,7 @@ func ValidateURI(baseURI string, redirectURI string) (bool, error) {
 }
 
 // ParseStringToQueryString is a function to parse string to QueryStrings.
+// The first error of unescaping the values is returned, the value which cannot be unescaped is kept empty.
 func ParseStringToQueryString(query string) (url.Values, error) {
 	m := make(url.Values)
 	err := parseQuery(m, query)
@@ -75,7 +76,10 @@ func parseQuery(m url.Values, query string) (err error) {
 			key, value = key[:i], key[i+1:]
 		}
 
-		unescape, _ := url.QueryUnescape(value)
+		unescape, errUnescape := url.QueryUnescape(value)
+		if errUnescape != nil && err == nil {
+			err = errUnescape
+		}
 
 		m[key] = append(m[key], unescape)
 	}
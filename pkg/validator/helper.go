@@ -3,6 +3,7 @@ package validator
 import (
 	"errors"
 	"fmt"
+	"micro/pkg/util"
 	"reflect"
 	"strconv"
 	"strings"
@@ -72,6 +73,39 @@ func EqualValue(targetValue string) validation.RuleFunc {
 	}
 }
 
+// ByteSizeValue is a closure uses by ValidationRules.IsByteSize rule.
+func ByteSizeValue() validation.RuleFunc {
+	return func(fieldValue interface{}) error {
+		s, _ := fieldValue.(string)
+		if s == "" {
+			return nil
+		}
+
+		_, err := util.ParseByteSize(s)
+		if err != nil {
+			return errors.New("validation.error.must_be_valid_byte_size")
+		}
+		return nil
+	}
+}
+
+// UniqueValue is a closure uses by ValidationRules.Unique rule.
+// The empty value is skipped, the error of exists is ignored to let the unique constraint of the storage decide.
+func UniqueValue(exists func(value interface{}) (bool, error)) validation.RuleFunc {
+	return func(fieldValue interface{}) error {
+		value, isNil := validation.Indirect(fieldValue)
+		if isNil || IsEmpty(value) {
+			return nil
+		}
+
+		taken, err := exists(value)
+		if err == nil && taken {
+			return errors.New("validation.error.already_exists")
+		}
+		return nil
+	}
+}
+
 // Required is a closure used by ValidationRules.Required rule.
 func Required() validation.RuleFunc {
 	return func(fieldValue interface{}) error {
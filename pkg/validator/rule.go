@@ -688,6 +688,27 @@ func (vr *ValidationRules) IsImagePNG() *ValidationRules {
 	return vr
 }
 
+// IsByteSize is a function to set the rule that current field value is valid byte size, e.g. 10MB or 1048576.
+func (vr *ValidationRules) IsByteSize() *ValidationRules {
+	vr.Rules = append(vr.Rules, ValidationRule{
+		Rule:    validation.By(ByteSizeValue()),
+		RuleOpt: nil,
+	})
+
+	return vr
+}
+
+// Unique is a function to set the rule that current field value must not be taken yet, exists reports whether the
+// value is taken, e.g. by another row of the storage.
+func (vr *ValidationRules) Unique(exists func(value interface{}) (bool, error)) *ValidationRules {
+	vr.Rules = append(vr.Rules, ValidationRule{
+		Rule:    validation.By(UniqueValue(exists)),
+		RuleOpt: nil,
+	})
+
+	return vr
+}
+
 // MaxFileSize is a function to set the rule that current field value must be no more than the file size. Size value in byte.
 func (vr *ValidationRules) MaxFileSize(size uint64) *ValidationRules {
 	vr.Rules = append(vr.Rules, ValidationRule{
@@ -1,6 +1,7 @@
 package validator_test
 
 import (
+	"errors"
 	"micro/pkg/validator"
 	"regexp"
 	"testing"
@@ -249,6 +250,42 @@ func TestValidatorValidationRulesEqualTo(t *testing.T) {
 	}
 }
 
+func TestValidatorValidationRulesIsByteSize(t *testing.T) {
+	validation := validator.New()
+	rules := validation.AddRule().IsByteSize().Apply()
+
+	for _, r := range rules {
+		assert.IsType(t, r.Rule, ozzoValidation.By(validator.ByteSizeValue()))
+		assert.Equal(t, r.RuleOpt, []validator.RuleOpt(nil))
+		assert.NoError(t, r.Rule.Validate("10MB"))
+		assert.Error(t, r.Rule.Validate("10XB"))
+	}
+}
+
+func TestValidatorValidationRulesUnique(t *testing.T) {
+	validation := validator.New()
+	exists := func(value interface{}) (bool, error) {
+		switch value {
+		case "taken":
+			return true, nil
+		case "unknown":
+			return true, errors.New("connection refused")
+		default:
+			return false, nil
+		}
+	}
+	rules := validation.AddRule().Unique(exists).Apply()
+
+	for _, r := range rules {
+		assert.IsType(t, r.Rule, ozzoValidation.By(validator.UniqueValue(exists)))
+		assert.Equal(t, r.RuleOpt, []validator.RuleOpt(nil))
+		assert.EqualError(t, r.Rule.Validate("taken"), "validation.error.already_exists")
+		assert.NoError(t, r.Rule.Validate("free"))
+		assert.NoError(t, r.Rule.Validate("unknown"))
+		assert.NoError(t, r.Rule.Validate(""))
+	}
+}
+
 func TestValidatorValidationRulesIsAlpha(t *testing.T) {
 	validation := validator.New()
 	rules := validation.AddRule().IsAlpha().Apply()
@@ -0,0 +1,205 @@
+package document
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"micro/domain/entity"
+	"micro/persistence"
+	"micro/pkg/filestore/object"
+	"micro/pkg/parameter"
+	"micro/pkg/validator"
+	commonv1 "micro/transport/grpc/common/v1"
+	"micro/transport/grpc/dependency"
+	"micro/transport/grpc/presenter"
+	"time"
+)
+
+// Handler is a struct represent itself.
+type Handler struct {
+	Dependency *dependency.Dependency
+
+	// It is for forward-compatibility, that if you changed your service files and added some new methods,
+	// your binary doesn't fail if you don't implement the new methods in your server.
+	// https://github.com/grpc/grpc-go/issues/3669
+	UnimplementedDocumentServiceServer
+}
+
+func (h *Handler) UploadDocument(ctx context.Context, request *UploadDocumentRequest) (*Document, error) {
+	validation := validator.New()
+	validation.
+		Set("category_id", request.CategoryId, validation.AddRule().Required().IsUUID().Apply()).
+		Set("original_name", request.OriginalName, validation.AddRule().Required().Length(1, 255).Apply()).
+		Set("content", request.Content, validation.AddRule().Required().Apply())
+
+	validationResult := validation.Validate()
+	if len(validationResult) > 0 {
+		return nil, presenter.
+			NewErrorPresenter(ctx, codes.InvalidArgument, "error.common.unprocessable_entity", validationResult.ToErrorRPCList()).
+			Error()
+	}
+
+	category, err := h.Dependency.DBClient.DocumentCategory.FindDocumentCategory(ctx, &entity.DocumentCategory{
+		ID: request.CategoryId,
+	})
+	if err != nil {
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
+	}
+
+	size := int64(len(request.Content))
+	if category.Size > 0 && size > category.Size {
+		return nil, presenter.
+			NewErrorPresenter(ctx, codes.InvalidArgument, "error.document.size_exceeded", nil).
+			Error()
+	}
+
+	// Check the quota before uploading the object, it is enforced again when the document is saved.
+	err = h.Dependency.DBClient.StorageUsage.CheckDocumentCategoryQuota(ctx, category, size, 1)
+	if err != nil {
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
+	}
+
+	metadata := object.NewFromByteSlice(request.Content, category.Slug,
+		object.WithOriginalName(request.OriginalName),
+		object.WithPutMethod(object.DirectPut),
+		object.IncludeSlug(),
+		object.IncludeDate(),
+	)
+
+	_, err = h.Dependency.FileStorageClient.Driver.PutObject(metadata)
+	if err != nil {
+		h.Dependency.Logger.Log.Errorf("Unable to upload document, err: %v", err)
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
+	}
+
+	// The category is taken again and the document is saved with its storage usage in a single transaction,
+	// so the category deleted or filled up while the object was uploaded is not used.
+	var document *entity.Document
+	err = h.Dependency.DBClient.WithTransaction(ctx, func(tx *persistence.DBClient) error {
+		current, err := tx.DocumentCategory.FindDocumentCategory(ctx, &entity.DocumentCategory{ID: category.ID})
+		if err != nil {
+			return err
+		}
+
+		document, err = tx.Document.SaveDocument(ctx, &entity.Document{
+			ID:           metadata.ID,
+			CategoryID:   current.ID,
+			OriginalName: metadata.OriginalName,
+			Name:         metadata.Filename(),
+			Path:         metadata.Filepath(),
+			Type:         metadata.ContentType,
+			Size:         metadata.Size,
+		})
+
+		return err
+	})
+	if err != nil {
+		errDelete := h.Dependency.FileStorageClient.Driver.DeleteObject(metadata.Filepath())
+		if errDelete != nil {
+			h.Dependency.Logger.Log.Errorf("Unable to delete uploaded object %s, err: %v", metadata.Filepath(), errDelete)
+		}
+
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
+	}
+
+	return toDocument(document), nil
+}
+
+func (h *Handler) GetDocuments(ctx context.Context, request *GetDocumentsRequest) (*Documents, error) {
+	var dataEntity entity.Document
+
+	sqlParameters, violations := parameter.NewRPCQueryParameters(request.GetQuery(), &dataEntity)
+	if len(violations) > 0 {
+		return nil, presenter.
+			NewErrorPresenter(ctx, codes.InvalidArgument, "error.common.unprocessable_entity", violations).
+			Error()
+	}
+
+	getDocuments := h.Dependency.DBClient.Document.GetDocuments
+	if sqlParameters.Trashed {
+		getDocuments = h.Dependency.DBClient.Document.GetDeletedDocuments
+	}
+
+	documents, meta, err := getDocuments(ctx, sqlParameters)
+	if err != nil {
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
+	}
+
+	data := make([]*Document, 0, len(documents))
+	for _, document := range documents {
+		item := toDocument(document)
+		presenter.Project(item, sqlParameters.Fields)
+		data = append(data, item)
+	}
+
+	return &Documents{
+		Data: data,
+		Meta: &commonv1.PageMeta{
+			Page:       int32(meta.Page),
+			PerPage:    int32(meta.PerPage),
+			Total:      meta.GetTotal(),
+			NextCursor: meta.NextCursor,
+			PrevCursor: meta.PrevCursor,
+		},
+	}, nil
+}
+
+func (h *Handler) RestoreDocument(ctx context.Context, request *RestoreDocumentRequest) (*Document, error) {
+	document, err := h.Dependency.DBClient.Document.RestoreDocument(ctx, &entity.Document{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
+	}
+
+	return toDocument(document), nil
+}
+
+func (h *Handler) UpdateDocument(ctx context.Context, request *UpdateDocumentRequest) (*Document, error) {
+	validation := validator.New()
+	validation.
+		Set("id", request.Id, validation.AddRule().Required().IsUUID().Apply()).
+		Set("category_id", request.CategoryId, validation.AddRule().IsUUID().Apply()).
+		Set("original_name", request.OriginalName, validation.AddRule().Length(0, 255).Apply())
+
+	validationResult := validation.Validate()
+	if len(validationResult) > 0 {
+		return nil, presenter.
+			NewErrorPresenter(ctx, codes.InvalidArgument, "error.common.unprocessable_entity", validationResult.ToErrorRPCList()).
+			Error()
+	}
+
+	document := &entity.Document{ID: request.Id, Version: request.ExpectedVersion}
+	err := h.Dependency.DBClient.Document.UpdateDocument(ctx, document, &entity.Document{
+		CategoryID:   request.CategoryId,
+		OriginalName: request.OriginalName,
+	})
+	if err != nil {
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
+	}
+
+	return toDocument(document), nil
+}
+
+// toDocument convert entity.Document to Document message.
+func toDocument(document *entity.Document) *Document {
+	message := &Document{
+		Id:           document.ID,
+		CategoryId:   document.CategoryID,
+		OriginalName: document.OriginalName,
+		Name:         document.Name,
+		Path:         document.Path,
+		Type:         document.Type,
+		Size:         document.Size,
+		CreatedAt:    document.CreatedAt.Format(time.RFC3339),
+		Version:      document.Version,
+	}
+
+	if document.DeletedAt.Valid {
+		message.DeletedAt = document.DeletedAt.Time.Format(time.RFC3339)
+	}
+
+	return message
+}
+
+// Type assertion ensure that Handler implements DocumentServiceServer.
+var _ DocumentServiceServer = &Handler{}
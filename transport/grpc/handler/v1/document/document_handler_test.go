@@ -0,0 +1,146 @@
+package document_test
+
+import (
+	"context"
+	"micro/domain/entity"
+	"micro/domain/factory"
+	"micro/domain/registry"
+	"micro/persistence"
+	"micro/pkg/configurator"
+	"micro/pkg/filestore/driver/memory"
+	"micro/pkg/provider/connection"
+	commonv1 "micro/transport/grpc/common/v1"
+	"micro/transport/grpc/dependency"
+	"micro/transport/grpc/handler/v1/document"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestDBClient return the config and DBClient of a new SQLite test database which is migrated from the entities.
+func newTestDBClient(t *testing.T) (*configurator.Config, *persistence.DBClient) {
+	t.Helper()
+
+	config := &configurator.Config{TestMode: true, MaxIdleCons: 2, MaxOpenCons: 4}
+	config.DBTestConfig = configurator.DBTestConfig{DBDriver: "sqlite", DBName: filepath.Join(t.TempDir(), "micro_test.db")}
+
+	db, err := connection.NewDBConnection(config)
+	require.NoError(t, err)
+	require.NoError(t, registry.NewRegistry().AutoMigrate(db))
+
+	dbClient := persistence.NewDBService(db)
+
+	return config, dbClient
+}
+
+func TestDocumentHandlerTrashAndRestore(t *testing.T) {
+	ctx := context.Background()
+
+	config, dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+	active := f.Document().MustCreate(t)
+	trashed := f.Document().MustCreate(t)
+	_, err := dbClient.Document.DeleteDocument(ctx, trashed)
+	require.NoError(t, err)
+
+	handler := &document.Handler{Dependency: &dependency.Dependency{Config: config, DBClient: dbClient}}
+
+	documents, err := handler.GetDocuments(ctx, &document.GetDocumentsRequest{})
+	require.NoError(t, err)
+	require.Len(t, documents.Data, 1)
+	assert.Equal(t, active.ID, documents.Data[0].Id)
+	assert.Empty(t, documents.Data[0].DeletedAt)
+	assert.Equal(t, int64(1), documents.Meta.Total)
+
+	documents, err = handler.GetDocuments(ctx, &document.GetDocumentsRequest{Query: &commonv1.Query{Trashed: true}})
+	require.NoError(t, err)
+	require.Len(t, documents.Data, 1)
+	assert.Equal(t, trashed.ID, documents.Data[0].Id)
+	assert.NotEmpty(t, documents.Data[0].DeletedAt)
+
+	restored, err := handler.RestoreDocument(ctx, &document.RestoreDocumentRequest{Id: trashed.ID})
+	require.NoError(t, err)
+	assert.Equal(t, trashed.ID, restored.Id)
+	assert.Empty(t, restored.DeletedAt)
+
+	documents, err = handler.GetDocuments(ctx, &document.GetDocumentsRequest{Query: &commonv1.Query{Trashed: true}})
+	require.NoError(t, err)
+	assert.Empty(t, documents.Data)
+
+	_, err = handler.RestoreDocument(ctx, &document.RestoreDocumentRequest{Id: trashed.ID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDocumentHandlerUploadDocument(t *testing.T) {
+	ctx := context.Background()
+
+	config, dbClient := newTestDBClient(t)
+	driver := memory.NewDriver()
+	category := factory.New(dbClient, driver).DocumentCategory().WithQuota(0, 1).MustCreate(t)
+
+	handler := &document.Handler{Dependency: &dependency.Dependency{
+		Config:            config,
+		DBClient:          dbClient,
+		FileStorageClient: persistence.NewFileStoreService(driver),
+	}}
+
+	uploaded, err := handler.UploadDocument(ctx, &document.UploadDocumentRequest{
+		CategoryId:   category.ID,
+		OriginalName: "invoice.pdf",
+		Content:      []byte("%PDF-1.4\n%%EOF\n"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, category.ID, uploaded.CategoryId)
+	assert.True(t, driver.HasObject(uploaded.Path))
+
+	usage, err := dbClient.StorageUsage.FindStorageUsage(ctx, &entity.StorageUsage{
+		SubjectType: entity.StorageSubjectDocumentCategory,
+		SubjectID:   category.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), usage.UsedObjects)
+	assert.Equal(t, uploaded.Size, usage.UsedBytes)
+
+	_, err = handler.UploadDocument(ctx, &document.UploadDocumentRequest{
+		CategoryId:   category.ID,
+		OriginalName: "receipt.pdf",
+		Content:      []byte("%PDF-1.4\n%%EOF\n"),
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestDocumentHandlerUpdateDocument(t *testing.T) {
+	ctx := context.Background()
+
+	config, dbClient := newTestDBClient(t)
+	f := factory.New(dbClient, memory.NewDriver())
+	created := f.Document().MustCreate(t)
+	other := f.DocumentCategory().MustCreate(t)
+
+	handler := &document.Handler{Dependency: &dependency.Dependency{Config: config, DBClient: dbClient}}
+
+	updated, err := handler.UpdateDocument(ctx, &document.UpdateDocumentRequest{
+		Id:              created.ID,
+		CategoryId:      other.ID,
+		OriginalName:    "moved.pdf",
+		ExpectedVersion: created.Version,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, other.ID, updated.CategoryId)
+	assert.Equal(t, "moved.pdf", updated.OriginalName)
+	assert.Equal(t, created.Version+1, updated.Version)
+
+	_, err = handler.UpdateDocument(ctx, &document.UpdateDocumentRequest{
+		Id:              created.ID,
+		OriginalName:    "stale.pdf",
+		ExpectedVersion: created.Version,
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	_, err = handler.UpdateDocument(ctx, &document.UpdateDocumentRequest{Id: created.ID, CategoryId: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -25,6 +25,7 @@ const (
 	DocumentCategoryService_GetDocumentCategories_FullMethodName      = "/micro.transport.grpc.handler.v1.documentcategory.DocumentCategoryService/GetDocumentCategories"
 	DocumentCategoryService_SaveDocumentCategory_FullMethodName       = "/micro.transport.grpc.handler.v1.documentcategory.DocumentCategoryService/SaveDocumentCategory"
 	DocumentCategoryService_UpdateDocumentCategory_FullMethodName     = "/micro.transport.grpc.handler.v1.documentcategory.DocumentCategoryService/UpdateDocumentCategory"
+	DocumentCategoryService_RestoreDocumentCategory_FullMethodName    = "/micro.transport.grpc.handler.v1.documentcategory.DocumentCategoryService/RestoreDocumentCategory"
 )
 
 // DocumentCategoryServiceClient is the client API for DocumentCategoryService service.
@@ -37,6 +38,7 @@ type DocumentCategoryServiceClient interface {
 	GetDocumentCategories(ctx context.Context, in *GetDocumentCategoriesRequest, opts ...grpc.CallOption) (*DocumentCategories, error)
 	SaveDocumentCategory(ctx context.Context, in *SaveDocumentCategoryRequest, opts ...grpc.CallOption) (*DocumentCategory, error)
 	UpdateDocumentCategory(ctx context.Context, in *UpdateDocumentCategoryRequest, opts ...grpc.CallOption) (*DocumentCategory, error)
+	RestoreDocumentCategory(ctx context.Context, in *RestoreDocumentCategoryRequest, opts ...grpc.CallOption) (*DocumentCategory, error)
 }
 
 type documentCategoryServiceClient struct {
@@ -101,6 +103,15 @@ func (c *documentCategoryServiceClient) UpdateDocumentCategory(ctx context.Conte
 	return out, nil
 }
 
+func (c *documentCategoryServiceClient) RestoreDocumentCategory(ctx context.Context, in *RestoreDocumentCategoryRequest, opts ...grpc.CallOption) (*DocumentCategory, error) {
+	out := new(DocumentCategory)
+	err := c.cc.Invoke(ctx, DocumentCategoryService_RestoreDocumentCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DocumentCategoryServiceServer is the server API for DocumentCategoryService service.
 // All implementations must embed UnimplementedDocumentCategoryServiceServer
 // for forward compatibility
@@ -111,6 +122,7 @@ type DocumentCategoryServiceServer interface {
 	GetDocumentCategories(context.Context, *GetDocumentCategoriesRequest) (*DocumentCategories, error)
 	SaveDocumentCategory(context.Context, *SaveDocumentCategoryRequest) (*DocumentCategory, error)
 	UpdateDocumentCategory(context.Context, *UpdateDocumentCategoryRequest) (*DocumentCategory, error)
+	RestoreDocumentCategory(context.Context, *RestoreDocumentCategoryRequest) (*DocumentCategory, error)
 	mustEmbedUnimplementedDocumentCategoryServiceServer()
 }
 
@@ -136,6 +148,9 @@ func (UnimplementedDocumentCategoryServiceServer) SaveDocumentCategory(context.C
 func (UnimplementedDocumentCategoryServiceServer) UpdateDocumentCategory(context.Context, *UpdateDocumentCategoryRequest) (*DocumentCategory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDocumentCategory not implemented")
 }
+func (UnimplementedDocumentCategoryServiceServer) RestoreDocumentCategory(context.Context, *RestoreDocumentCategoryRequest) (*DocumentCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDocumentCategory not implemented")
+}
 func (UnimplementedDocumentCategoryServiceServer) mustEmbedUnimplementedDocumentCategoryServiceServer() {
 }
 
@@ -258,6 +273,24 @@ func _DocumentCategoryService_UpdateDocumentCategory_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentCategoryService_RestoreDocumentCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDocumentCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentCategoryServiceServer).RestoreDocumentCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentCategoryService_RestoreDocumentCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentCategoryServiceServer).RestoreDocumentCategory(ctx, req.(*RestoreDocumentCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DocumentCategoryService_ServiceDesc is the grpc.ServiceDesc for DocumentCategoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +322,10 @@ var DocumentCategoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDocumentCategory",
 			Handler:    _DocumentCategoryService_UpdateDocumentCategory_Handler,
 		},
+		{
+			MethodName: "RestoreDocumentCategory",
+			Handler:    _DocumentCategoryService_RestoreDocumentCategory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "transport/grpc/handler/v1/documentcategory/documentcategory.proto",
@@ -2,12 +2,15 @@ package documentcategory
 
 import (
 	"context"
-	"errors"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
-	"gorm.io/gorm"
+	"math"
 	"micro/domain/entity"
+	"micro/pkg/exception"
 	"micro/pkg/parameter"
+	"micro/pkg/util"
+	"micro/pkg/validator"
+	commonv1 "micro/transport/grpc/common/v1"
 	"micro/transport/grpc/dependency"
 	"micro/transport/grpc/presenter"
 	"time"
@@ -27,24 +30,15 @@ func (h *Handler) DeleteDocumentCategory(ctx context.Context, request *DeleteDoc
 	category, err := h.Dependency.DBClient.DocumentCategory.FindDocumentCategory(ctx, &entity.DocumentCategory{
 		ID: request.Id,
 	})
-	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.NotFound, "error.document_category.not_found", nil).
-			Error()
-	}
 	if err != nil {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.Internal, "error.common.internal_server_error", nil).
-			Error()
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
 	}
 
 	category, err = h.Dependency.DBClient.DocumentCategory.DeleteDocumentCategory(ctx, &entity.DocumentCategory{
 		ID: request.Id,
 	})
 	if err != nil {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.Internal, "error.common.internal_server_error", nil).
-			Error()
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
 	}
 
 	return &DocumentCategoryDeleted{DeletedAt: category.DeletedAt.Time.Format(time.RFC3339)}, nil
@@ -54,176 +48,263 @@ func (h *Handler) FindDocumentCategory(ctx context.Context, request *FindDocumen
 	category, err := h.Dependency.DBClient.DocumentCategory.FindDocumentCategory(ctx, &entity.DocumentCategory{
 		ID: request.Id,
 	})
-	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.NotFound, "error.document_category.not_found", nil).
-			Error()
+	if err != nil {
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
 	}
+
+	documentCategory, err := h.toDocumentCategoryWithUsage(ctx, category)
 	if err != nil {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.Internal, "error.common.internal_server_error", nil).
-			Error()
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
 	}
 
-	return &DocumentCategory{
-		Id:        category.ID,
-		Name:      category.Name,
-		Slug:      category.Slug,
-		Size:      category.Size,
-		MimeTypes: category.MimeTypes,
-		Desc:      category.Description,
-		CreatedAt: category.CreatedAt.Format(time.RFC3339),
-	}, nil
+	return documentCategory, nil
 }
 
 func (h *Handler) FindDocumentCategoryBySlug(ctx context.Context, request *FindDocumentCategoryBySlugRequest) (*DocumentCategory, error) {
 	category, err := h.Dependency.DBClient.DocumentCategory.FindDocumentCategoryBySlug(ctx, &entity.DocumentCategory{
 		Slug: request.Slug,
 	})
-	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.NotFound, "error.document_category.not_found", nil).
-			Error()
+	if err != nil {
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
 	}
+
+	documentCategory, err := h.toDocumentCategoryWithUsage(ctx, category)
 	if err != nil {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.Internal, "error.common.internal_server_error", nil).
-			Error()
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
 	}
 
-	return &DocumentCategory{
-		Id:        category.ID,
-		Name:      category.Name,
-		Slug:      category.Slug,
-		Size:      category.Size,
-		MimeTypes: category.MimeTypes,
-		Desc:      category.Description,
-		CreatedAt: category.CreatedAt.Format(time.RFC3339),
-	}, nil
+	return documentCategory, nil
 }
 
 func (h *Handler) GetDocumentCategories(ctx context.Context, request *GetDocumentCategoriesRequest) (*DocumentCategories, error) {
 	var dataEntity entity.DocumentCategory
 
-	reqParameters := request.Parameters
-	rpcParameters := parameter.RPCParameters{
-		SearchCondition: reqParameters.SearchCondition,
-		Page:            int(reqParameters.Page),
-		PerPage:         int(reqParameters.PerPage),
-		OrderBy:         reqParameters.OrderBy,
-		OrderMethod:     reqParameters.OrderMethod,
-		Equal:           reqParameters.Equal,
-		Not:             reqParameters.Not,
-		Like:            reqParameters.Like,
-		DateRangeBy:     reqParameters.DateRangeBy,
-		DateStart:       reqParameters.DateStart,
-		DateEnd:         reqParameters.DateEnd,
-	}
-	sqlParameters := rpcParameters.ToSQLQueryParameters()
-
-	validationResult := sqlParameters.ValidateParameter(dataEntity.FilterableFields(), dataEntity.TimeFields())
-	if len(validationResult) > 0 {
+	sqlParameters, violations := toSQLQueryParameters(request.Parameters, &dataEntity)
+	if len(violations) > 0 {
 		return nil, presenter.
-			NewErrorPresenter(ctx, codes.InvalidArgument, "error.common.unprocessable_entity", nil).
+			NewErrorPresenter(ctx, codes.InvalidArgument, "error.common.unprocessable_entity", violations).
 			Error()
 	}
 
-	categories, meta, err := h.Dependency.DBClient.DocumentCategory.GetDocumentCategories(ctx, sqlParameters)
+	getDocumentCategories := h.Dependency.DBClient.DocumentCategory.GetDocumentCategories
+	if sqlParameters.Trashed {
+		getDocumentCategories = h.Dependency.DBClient.DocumentCategory.GetDeletedDocumentCategories
+	}
+
+	categories, meta, err := getDocumentCategories(ctx, sqlParameters)
 	if err != nil {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.Internal, "error.common.internal_server_error", nil).
-			Error()
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
 	}
 
 	return &DocumentCategories{
 		Data: func() []*DocumentCategory {
 			var documentCategories []*DocumentCategory
 			for _, category := range categories {
-				documentCategories = append(documentCategories, &DocumentCategory{
-					Id:        category.ID,
-					Name:      category.Name,
-					Slug:      category.Slug,
-					Size:      category.Size,
-					MimeTypes: category.MimeTypes,
-					Desc:      category.Description,
-					CreatedAt: category.CreatedAt.Format(time.RFC3339),
-				})
+				documentCategory := toDocumentCategory(category)
+				presenter.Project(documentCategory, sqlParameters.Fields)
+				documentCategories = append(documentCategories, documentCategory)
 			}
 
 			return documentCategories
 		}(),
 		Meta: &DocumentCategoryMeta{
-			Page:    int32(meta.Page),
-			PerPage: int32(meta.PerPage),
-			Total:   int32(meta.Total),
+			Page:       int32(meta.Page),
+			PerPage:    int32(meta.PerPage),
+			Total:      int32(meta.GetTotal()),
+			NextCursor: meta.NextCursor,
+			PrevCursor: meta.PrevCursor,
+			PageMeta: &commonv1.PageMeta{
+				Page:       int32(meta.Page),
+				PerPage:    int32(meta.PerPage),
+				Total:      meta.GetTotal(),
+				NextCursor: meta.NextCursor,
+				PrevCursor: meta.PrevCursor,
+			},
 		},
 	}, nil
 }
 
 func (h *Handler) SaveDocumentCategory(ctx context.Context, request *SaveDocumentCategoryRequest) (*DocumentCategory, error) {
+	id := uuid.New().String()
+	validationResult := validateDocumentCategory(request.SizeFormatted)
+	if len(validationResult) > 0 {
+		return nil, presenter.
+			NewErrorPresenter(ctx, codes.InvalidArgument, "error.common.unprocessable_entity", validationResult.ToErrorRPCList()).
+			Error()
+	}
+
 	category, err := h.Dependency.DBClient.DocumentCategory.SaveDocumentCategory(ctx, &entity.DocumentCategory{
-		ID:          uuid.New().String(),
-		Slug:        request.Slug,
-		Name:        request.Name,
-		Description: request.Description,
-		MimeTypes:   request.MimeTypes,
-		Size:        request.Size,
+		ID:                    id,
+		Slug:                  request.Slug,
+		Name:                  request.Name,
+		Description:           request.Description,
+		MimeTypes:             request.MimeTypes,
+		Size:                  toSizeBytes(request.SizeFormatted, request.Size),
+		PurgeDeletedAfterDays: int(request.PurgeDeletedAfterDays),
+		ExpireActiveAfterDays: int(request.ExpireActiveAfterDays),
+		QuotaBytes:            request.QuotaBytes,
+		QuotaObjects:          request.QuotaObjects,
 	})
 	if err != nil {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.Internal, "error.common.internal_server_error", nil).
-			Error()
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
 	}
 
-	return &DocumentCategory{
-		Id:        category.ID,
-		Name:      category.Name,
-		Slug:      category.Slug,
-		Size:      category.Size,
-		MimeTypes: category.MimeTypes,
-		Desc:      category.Description,
-		CreatedAt: category.CreatedAt.Format(time.RFC3339),
-	}, nil
+	return toDocumentCategory(category), nil
 }
 
 func (h *Handler) UpdateDocumentCategory(ctx context.Context, request *UpdateDocumentCategoryRequest) (*DocumentCategory, error) {
-	category, err := h.Dependency.DBClient.DocumentCategory.FindDocumentCategory(ctx, &entity.DocumentCategory{
-		ID: request.Id,
-	})
-	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
+	validationResult := validateDocumentCategory(request.SizeFormatted)
+	if len(validationResult) > 0 {
 		return nil, presenter.
-			NewErrorPresenter(ctx, codes.NotFound, "error.document_category.not_found", nil).
+			NewErrorPresenter(ctx, codes.InvalidArgument, "error.common.unprocessable_entity", validationResult.ToErrorRPCList()).
 			Error()
 	}
+
+	category, err := h.Dependency.DBClient.DocumentCategory.FindDocumentCategory(ctx, &entity.DocumentCategory{
+		ID: request.Id,
+	})
 	if err != nil {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.Internal, "error.common.internal_server_error", nil).
-			Error()
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
 	}
 
 	category, err = h.Dependency.DBClient.DocumentCategory.UpdateDocumentCategory(ctx, &entity.DocumentCategory{
-		ID:          category.ID,
-		Slug:        request.Slug,
-		Name:        request.Name,
-		Description: request.Description,
-		MimeTypes:   request.MimeTypes,
-		Size:        request.Size,
+		ID:                    category.ID,
+		Slug:                  request.Slug,
+		Name:                  request.Name,
+		Description:           request.Description,
+		MimeTypes:             request.MimeTypes,
+		Size:                  toSizeBytes(request.SizeFormatted, request.Size),
+		PurgeDeletedAfterDays: int(request.PurgeDeletedAfterDays),
+		ExpireActiveAfterDays: int(request.ExpireActiveAfterDays),
+		QuotaBytes:            request.QuotaBytes,
+		QuotaObjects:          request.QuotaObjects,
+		Version:               request.ExpectedVersion,
 	})
 	if err != nil {
-		return nil, presenter.
-			NewErrorPresenter(ctx, codes.Internal, "error.common.internal_server_error", nil).
-			Error()
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
 	}
 
-	return &DocumentCategory{
-		Id:        category.ID,
-		Name:      category.Name,
-		Slug:      category.Slug,
-		Size:      category.Size,
-		MimeTypes: category.MimeTypes,
-		Desc:      category.Description,
-		CreatedAt: category.CreatedAt.Format(time.RFC3339),
-	}, nil
+	return toDocumentCategory(category), nil
+}
+
+func (h *Handler) RestoreDocumentCategory(ctx context.Context, request *RestoreDocumentCategoryRequest) (*DocumentCategory, error) {
+	category, err := h.Dependency.DBClient.DocumentCategory.RestoreDocumentCategory(ctx, &entity.DocumentCategory{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
+	}
+
+	return toDocumentCategory(category), nil
+}
+
+// toDocumentCategory convert entity.DocumentCategory to DocumentCategory message.
+func toDocumentCategory(category *entity.DocumentCategory) *DocumentCategory {
+	documentCategory := &DocumentCategory{
+		Id:                    category.ID,
+		Name:                  category.Name,
+		Slug:                  category.Slug,
+		Size:                  float64(category.Size),
+		SizeBytes:             category.Size,
+		SizeFormatted:         category.SizeFormatted(),
+		MimeTypes:             category.MimeTypes,
+		Desc:                  category.Description,
+		CreatedAt:             category.CreatedAt.Format(time.RFC3339),
+		PurgeDeletedAfterDays: int32(category.PurgeDeletedAfterDays),
+		ExpireActiveAfterDays: int32(category.ExpireActiveAfterDays),
+		LegalHold:             category.LegalHold,
+		LegalHoldReason:       category.LegalHoldReason,
+		LegalHoldBy:           category.LegalHoldBy,
+		LegalHoldAt:           formatLegalHoldAt(category.LegalHoldAt),
+		QuotaBytes:            category.QuotaBytes,
+		QuotaObjects:          category.QuotaObjects,
+		Version:               category.Version,
+	}
+
+	if category.DeletedAt.Valid {
+		documentCategory.DeletedAt = category.DeletedAt.Time.Format(time.RFC3339)
+	}
+
+	return documentCategory
+}
+
+// toDocumentCategoryWithUsage convert entity.DocumentCategory to DocumentCategory message including its storage usage.
+func (h *Handler) toDocumentCategoryWithUsage(ctx context.Context, category *entity.DocumentCategory) (*DocumentCategory, error) {
+	usage, err := h.Dependency.DBClient.StorageUsage.FindStorageUsage(ctx, &entity.StorageUsage{
+		SubjectType: entity.StorageSubjectDocumentCategory,
+		SubjectID:   category.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	documentCategory := toDocumentCategory(category)
+	documentCategory.UsedBytes = usage.UsedBytes
+	documentCategory.UsedObjects = usage.UsedObjects
+
+	return documentCategory, nil
+}
+
+// validateDocumentCategory validate the human readable size limit of the request.
+func validateDocumentCategory(sizeFormatted string) exception.ErrorValidators {
+	validation := validator.New()
+	validation.
+		Set("size_formatted", sizeFormatted, validation.AddRule().IsByteSize().Apply())
+
+	return validation.Validate()
+}
+
+// toSizeBytes return the size limit in bytes, the human readable size takes precedence over the deprecated size.
+func toSizeBytes(sizeFormatted string, size float64) int64 {
+	if sizeFormatted == "" {
+		return int64(math.Ceil(size))
+	}
+
+	bytes, _ := util.ParseByteSize(sizeFormatted)
+
+	return int64(bytes)
+}
+
+// toSQLQueryParameters convert the list parameters of the request to SQLQueryParameters, the typed query takes
+// precedence over the deprecated query string parameters. The field violations are returned when it is invalid.
+func toSQLQueryParameters(reqParameters *DocumentCategoryParameterRequest, fields parameter.Fields) (*parameter.SQLQueryParameters, exception.ErrorRPCList) {
+	if reqParameters.GetQuery() != nil {
+		return parameter.NewRPCQueryParameters(reqParameters.GetQuery(), fields)
+	}
+
+	rpcParameters := parameter.RPCParameters{
+		SearchCondition: reqParameters.GetSearchCondition(),
+		Page:            int(reqParameters.GetPage()),
+		PerPage:         int(reqParameters.GetPerPage()),
+		OrderBy:         reqParameters.GetOrderBy(),
+		OrderMethod:     reqParameters.GetOrderMethod(),
+		Equal:           reqParameters.GetEqual(),
+		Not:             reqParameters.GetNot(),
+		Like:            reqParameters.GetLike(),
+		Filter:          reqParameters.GetFilter(),
+		DateRangeBy:     reqParameters.GetDateRangeBy(),
+		DateStart:       reqParameters.GetDateStart(),
+		DateEnd:         reqParameters.GetDateEnd(),
+		Trashed:         reqParameters.GetTrashed(),
+		Cursor:          reqParameters.GetCursor(),
+		SkipTotal:       reqParameters.GetSkipTotal(),
+		Sort:            reqParameters.GetSort(),
+		Fields:          reqParameters.GetFields().GetPaths(),
+
+		FilterExpression: parameter.FromRPCFilterExpression(reqParameters.GetFilterExpression()),
+	}
+	sqlParameters := rpcParameters.ToSQLQueryParameters()
+
+	return sqlParameters, sqlParameters.ValidateParameter(fields).ToErrorRPCList()
+}
+
+// formatLegalHoldAt format the time of legal hold placement, an empty string is returned when it is not on legal hold.
+func formatLegalHoldAt(legalHoldAt *time.Time) string {
+	if legalHoldAt == nil {
+		return ""
+	}
+
+	return legalHoldAt.Format(time.RFC3339)
 }
 
 // Type assertion ensure that Handler implements DocumentCategoryServiceServer.
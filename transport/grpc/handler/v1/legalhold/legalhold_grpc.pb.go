@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: transport/grpc/handler/v1/legalhold/legalhold.proto
+
+package legalhold
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LegalHoldService_PlaceLegalHold_FullMethodName   = "/micro.transport.grpc.handler.v1.legalhold.LegalHoldService/PlaceLegalHold"
+	LegalHoldService_ReleaseLegalHold_FullMethodName = "/micro.transport.grpc.handler.v1.legalhold.LegalHoldService/ReleaseLegalHold"
+)
+
+// LegalHoldServiceClient is the client API for LegalHoldService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LegalHoldServiceClient interface {
+	PlaceLegalHold(ctx context.Context, in *PlaceLegalHoldRequest, opts ...grpc.CallOption) (*LegalHoldAudit, error)
+	ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldRequest, opts ...grpc.CallOption) (*LegalHoldAudit, error)
+}
+
+type legalHoldServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLegalHoldServiceClient(cc grpc.ClientConnInterface) LegalHoldServiceClient {
+	return &legalHoldServiceClient{cc}
+}
+
+func (c *legalHoldServiceClient) PlaceLegalHold(ctx context.Context, in *PlaceLegalHoldRequest, opts ...grpc.CallOption) (*LegalHoldAudit, error) {
+	out := new(LegalHoldAudit)
+	err := c.cc.Invoke(ctx, LegalHoldService_PlaceLegalHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legalHoldServiceClient) ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldRequest, opts ...grpc.CallOption) (*LegalHoldAudit, error) {
+	out := new(LegalHoldAudit)
+	err := c.cc.Invoke(ctx, LegalHoldService_ReleaseLegalHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LegalHoldServiceServer is the server API for LegalHoldService service.
+// All implementations must embed UnimplementedLegalHoldServiceServer
+// for forward compatibility
+type LegalHoldServiceServer interface {
+	PlaceLegalHold(context.Context, *PlaceLegalHoldRequest) (*LegalHoldAudit, error)
+	ReleaseLegalHold(context.Context, *ReleaseLegalHoldRequest) (*LegalHoldAudit, error)
+	mustEmbedUnimplementedLegalHoldServiceServer()
+}
+
+// UnimplementedLegalHoldServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLegalHoldServiceServer struct {
+}
+
+func (UnimplementedLegalHoldServiceServer) PlaceLegalHold(context.Context, *PlaceLegalHoldRequest) (*LegalHoldAudit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLegalHold not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) ReleaseLegalHold(context.Context, *ReleaseLegalHoldRequest) (*LegalHoldAudit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLegalHold not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) mustEmbedUnimplementedLegalHoldServiceServer() {}
+
+// UnsafeLegalHoldServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LegalHoldServiceServer will
+// result in compilation errors.
+type UnsafeLegalHoldServiceServer interface {
+	mustEmbedUnimplementedLegalHoldServiceServer()
+}
+
+func RegisterLegalHoldServiceServer(s grpc.ServiceRegistrar, srv LegalHoldServiceServer) {
+	s.RegisterService(&LegalHoldService_ServiceDesc, srv)
+}
+
+func _LegalHoldService_PlaceLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).PlaceLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_PlaceLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).PlaceLegalHold(ctx, req.(*PlaceLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegalHoldService_ReleaseLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).ReleaseLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_ReleaseLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).ReleaseLegalHold(ctx, req.(*ReleaseLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LegalHoldService_ServiceDesc is the grpc.ServiceDesc for LegalHoldService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LegalHoldService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "micro.transport.grpc.handler.v1.legalhold.LegalHoldService",
+	HandlerType: (*LegalHoldServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlaceLegalHold",
+			Handler:    _LegalHoldService_PlaceLegalHold_Handler,
+		},
+		{
+			MethodName: "ReleaseLegalHold",
+			Handler:    _LegalHoldService_ReleaseLegalHold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "transport/grpc/handler/v1/legalhold/legalhold.proto",
+}
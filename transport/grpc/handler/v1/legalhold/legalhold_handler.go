@@ -0,0 +1,90 @@
+package legalhold
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"micro/domain/entity"
+	"micro/pkg/exception"
+	"micro/pkg/validator"
+	"micro/transport/grpc/dependency"
+	"micro/transport/grpc/presenter"
+	"time"
+)
+
+// Handler is a struct represent itself.
+type Handler struct {
+	Dependency *dependency.Dependency
+
+	// It is for forward-compatibility, that if you changed your service files and added some new methods,
+	// your binary doesn't fail if you don't implement the new methods in your server.
+	// https://github.com/grpc/grpc-go/issues/3669
+	UnimplementedLegalHoldServiceServer
+}
+
+func (h *Handler) PlaceLegalHold(ctx context.Context, request *PlaceLegalHoldRequest) (*LegalHoldAudit, error) {
+	validationResult := validateLegalHoldRequest(request.SubjectType, request.SubjectId, request.Reason, request.Actor)
+	if len(validationResult) > 0 {
+		return nil, presenter.
+			NewErrorPresenter(ctx, codes.InvalidArgument, "error.common.unprocessable_entity", validationResult.ToErrorRPCList()).
+			Error()
+	}
+
+	audit, err := h.Dependency.DBClient.LegalHold.PlaceLegalHold(ctx, &entity.LegalHoldAudit{
+		SubjectType: request.SubjectType,
+		SubjectID:   request.SubjectId,
+		Reason:      request.Reason,
+		Actor:       request.Actor,
+	})
+	if err != nil {
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
+	}
+
+	return toLegalHoldAudit(audit), nil
+}
+
+func (h *Handler) ReleaseLegalHold(ctx context.Context, request *ReleaseLegalHoldRequest) (*LegalHoldAudit, error) {
+	validationResult := validateLegalHoldRequest(request.SubjectType, request.SubjectId, request.Reason, request.Actor)
+	if len(validationResult) > 0 {
+		return nil, presenter.
+			NewErrorPresenter(ctx, codes.InvalidArgument, "error.common.unprocessable_entity", validationResult.ToErrorRPCList()).
+			Error()
+	}
+
+	audit, err := h.Dependency.DBClient.LegalHold.ReleaseLegalHold(ctx, &entity.LegalHoldAudit{
+		SubjectType: request.SubjectType,
+		SubjectID:   request.SubjectId,
+		Reason:      request.Reason,
+		Actor:       request.Actor,
+	})
+	if err != nil {
+		return nil, presenter.NewErrorPresenterFromError(ctx, err).Error()
+	}
+
+	return toLegalHoldAudit(audit), nil
+}
+
+func validateLegalHoldRequest(subjectType string, subjectID string, reason string, actor string) exception.ErrorValidators {
+	validation := validator.New()
+	validation.
+		Set("subject_type", subjectType, validation.AddRule().Required().In(entity.LegalHoldSubjectDocument, entity.LegalHoldSubjectDocumentCategory).Apply()).
+		Set("subject_id", subjectID, validation.AddRule().Required().IsUUID().Apply()).
+		Set("reason", reason, validation.AddRule().Required().Length(1, 255).Apply()).
+		Set("actor", actor, validation.AddRule().Required().Length(1, 100).Apply())
+
+	return validation.Validate()
+}
+
+func toLegalHoldAudit(audit *entity.LegalHoldAudit) *LegalHoldAudit {
+	return &LegalHoldAudit{
+		Id:          audit.ID,
+		SubjectType: audit.SubjectType,
+		SubjectId:   audit.SubjectID,
+		Action:      audit.Action,
+		Reason:      audit.Reason,
+		Actor:       audit.Actor,
+		CreatedAt:   audit.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// Type assertion ensure that Handler implements LegalHoldServiceServer.
+var _ LegalHoldServiceServer = &Handler{}
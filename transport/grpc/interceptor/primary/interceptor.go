@@ -0,0 +1,44 @@
+package primary
+
+import (
+	"context"
+	"micro/pkg/provider/connection"
+	"micro/transport/grpc/interceptor"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataReadPrimary is the metadata key uses to force the reads of the call to the primary database.
+const MetadataReadPrimary = "x-read-primary"
+
+// UnaryServerInterceptor returns a new unary server interceptor which forces the reads to the primary database
+// when the call has x-read-primary metadata set to true.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (_ interface{}, err error) {
+		return handler(withPrimary(ctx), req)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptor which forces the reads to the primary database
+// when the call has x-read-primary metadata set to true.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := interceptor.WrapServerStream(ss)
+		wrapped.WrappedContext = withPrimary(ss.Context())
+
+		return handler(srv, wrapped)
+	}
+}
+
+// withPrimary return ctx which forces the reads to the primary database when it is requested by the metadata.
+func withPrimary(ctx context.Context) context.Context {
+	for _, value := range metadata.ValueFromIncomingContext(ctx, MetadataReadPrimary) {
+		if forced, _ := strconv.ParseBool(value); forced {
+			return connection.WithPrimary(ctx)
+		}
+	}
+
+	return ctx
+}
@@ -38,6 +38,14 @@ func NewErrorPresenter(ctx context.Context, code codes.Code, message string, err
 	}
 }
 
+// NewErrorPresenterFromError is a constructor to initialize Error of the domain error of err, the code, the message
+// and the details are mapped by exception.FromError as on the REST error middleware.
+func NewErrorPresenterFromError(ctx context.Context, err error) *Error {
+	errDomain := exception.FromError(err)
+
+	return NewErrorPresenter(ctx, errDomain.Kind.Code(), errDomain.Message, errDomain.Details)
+}
+
 // WithMessageData is a function to include message data in Error.
 func (ero *Error) WithMessageData(data map[string]interface{}) *Error {
 	ero.MessageData = data
@@ -71,7 +79,7 @@ func (ero *Error) WithDebug() *Error {
 func (ero *Error) Build() (*status.Status, error) {
 	errStatus := status.New(ero.Code, ero.Message)
 
-	if ero.ErrorRPC != nil && ero.Code == codes.InvalidArgument {
+	if ero.ErrorRPC != nil && (ero.Code == codes.InvalidArgument || ero.Code == codes.AlreadyExists) {
 		var errRPCFields []*rpc.BadRequest_FieldViolation
 
 		for _, errorField := range ero.ErrorRPC.ToErrorRPCFieldList() {
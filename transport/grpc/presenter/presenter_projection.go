@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Project will clear the fields of the message which are not listed on the paths of the field mask.
+// The message is kept as is when the paths are empty.
+func Project(message proto.Message, paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+
+	keep := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		keep[path] = true
+	}
+
+	var cleared []protoreflect.FieldDescriptor
+	m := message.ProtoReflect()
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !keep[string(fd.Name())] {
+			cleared = append(cleared, fd)
+		}
+		return true
+	})
+
+	for _, fd := range cleared {
+		m.Clear(fd)
+	}
+}
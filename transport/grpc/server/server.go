@@ -12,7 +12,10 @@ import (
 	"micro/pkg/util"
 	"micro/transport/grpc/dependency"
 	"micro/transport/grpc/handler/healthcheck"
+	"micro/transport/grpc/handler/v1/document"
 	"micro/transport/grpc/handler/v1/documentcategory"
+	"micro/transport/grpc/handler/v1/legalhold"
+	"micro/transport/grpc/interceptor/primary"
 	"micro/transport/grpc/interceptor/recovery"
 	"net/http"
 )
@@ -42,6 +45,7 @@ func (s *Server) Init() error {
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			recovery.UnaryServerInterceptor(),
+			primary.UnaryServerInterceptor(),
 			func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 				resp, err = handler(ctx, req)
 				if err != nil {
@@ -53,6 +57,7 @@ func (s *Server) Init() error {
 		),
 		grpc.ChainStreamInterceptor(
 			recovery.StreamServerInterceptor(),
+			primary.StreamServerInterceptor(),
 			func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 				err := handler(srv, ss)
 
@@ -70,12 +75,16 @@ func (s *Server) Init() error {
 	}
 
 	healthCheckHandler := &healthcheck.Handler{Dependency: dep}
+	documentHandler := &document.Handler{Dependency: dep}
 	documentCategoryHandler := &documentcategory.Handler{Dependency: dep}
+	legalHoldHandler := &legalhold.Handler{Dependency: dep}
 
 	health.RegisterHealthServer(server, healthCheckHandler)
 
 	// register gRPC handler
+	document.RegisterDocumentServiceServer(server, documentHandler)
 	documentcategory.RegisterDocumentCategoryServiceServer(server, documentCategoryHandler)
+	legalhold.RegisterLegalHoldServiceServer(server, legalHoldHandler)
 
 	// gRPC Server Reflection provides information about publicly-accessible gRPC services on a server,
 	// and assists clients at runtime to construct RPC requests and responses without precompiled service information.
@@ -0,0 +1,13 @@
+package list
+
+type Response struct {
+	ID           string `json:"id"`
+	CategoryID   string `json:"category_id"`
+	OriginalName string `json:"original_name"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Size         int64  `json:"size"`
+	LegalHold    bool   `json:"legal_hold"`
+	CreatedAt    string `json:"created_at"`
+	DeletedAt    string `json:"deleted_at,omitempty"`
+}
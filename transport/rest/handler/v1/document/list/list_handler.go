@@ -0,0 +1,84 @@
+package list
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"micro/domain/entity"
+	"micro/pkg/parameter"
+	"micro/transport/rest/dependency"
+	"micro/transport/rest/presenter"
+	"net/http"
+	"time"
+)
+
+// Handler holds the dependency.
+type Handler struct {
+	Dependency *dependency.Dependency
+}
+
+// ListDocuments will handle list documents request.
+// Soft-deleted documents are listed instead when the trashed query string is true.
+// @Summary Uses to list documents request
+// @Description Document.
+// @Tags Document API
+// @Accept  json
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Param trashed query bool false "List soft-deleted documents only"
+// @Param sort query string false "Fill with comma separated fields, prefix the field with - to sort descending, e.g. -created_at,name"
+// @Param fields query string false "Fill with comma separated fields of the response, e.g. id,name"
+// @Param filter query string false "Fill with JSON filter expression, e.g. {\"or\":[{\"field\":\"name\",\"op\":\"equal\",\"value\":\"a\"}]}"
+// @Param cursor query string false "Fill with next_cursor or prev_cursor of the previous response"
+// @Param skip_total query bool false "Skip counting the total rows"
+// @Success 200 {object} presenter.Success{data=[]list.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 401 {object} presenter.Error
+// @Failure 403 {object} presenter.Error
+// @Failure 422 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/documents [get]
+func (h *Handler) ListDocuments(c *gin.Context) {
+	var dataEntity entity.Document
+
+	sqlParameters := parameter.NewHTTPParameters(c)
+	validationResult := sqlParameters.ValidateParameter(&dataEntity)
+	if len(validationResult) > 0 {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, presenter.NewValidationError(errors.New("error.common.unprocessable_entity"), validationResult.ToErrorFieldList()))
+		return
+	}
+
+	getDocuments := h.Dependency.DBClient.Document.GetDocuments
+	if sqlParameters.Trashed {
+		getDocuments = h.Dependency.DBClient.Document.GetDeletedDocuments
+	}
+
+	documents, meta, err := getDocuments(c.Request.Context(), sqlParameters)
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	response := make([]*Response, 0, len(documents))
+	for _, document := range documents {
+		item := &Response{
+			ID:           document.ID,
+			CategoryID:   document.CategoryID,
+			OriginalName: document.OriginalName,
+			Name:         document.Name,
+			Type:         document.Type,
+			Size:         document.Size,
+			LegalHold:    document.LegalHold,
+			CreatedAt:    document.CreatedAt.Format(time.RFC3339),
+		}
+
+		if document.DeletedAt.Valid {
+			item.DeletedAt = document.DeletedAt.Time.Format(time.RFC3339)
+		}
+
+		response = append(response, item)
+	}
+
+	c.Status(http.StatusOK)
+	presenter.NewSuccessPresenter(c, response, "success.list_document").WithFields(sqlParameters.Fields).WithMeta(meta).JSON()
+}
@@ -0,0 +1,15 @@
+package restore
+
+type Request struct {
+	ID string `uri:"id"`
+}
+
+type Response struct {
+	ID           string `json:"id"`
+	CategoryID   string `json:"category_id"`
+	OriginalName string `json:"original_name"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Size         int64  `json:"size"`
+	CreatedAt    string `json:"created_at"`
+}
@@ -0,0 +1,59 @@
+package restore
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"micro/domain/entity"
+	"micro/transport/rest/dependency"
+	"micro/transport/rest/presenter"
+	"net/http"
+	"time"
+)
+
+// Handler holds the dependency.
+type Handler struct {
+	Dependency *dependency.Dependency
+}
+
+// RestoreDocument will handle restore soft-deleted document request.
+// @Summary Uses to restore soft-deleted document request
+// @Description Document.
+// @Tags Document API
+// @Accept  json
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Success 200 {object} presenter.Success{data=restore.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 401 {object} presenter.Error
+// @Failure 403 {object} presenter.Error
+// @Failure 404 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/documents/:id/restore [post]
+func (h *Handler) RestoreDocument(c *gin.Context) {
+	var payload Request
+	err := c.ShouldBindUri(&payload)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("error.common.bad_request"))
+		return
+	}
+
+	document, err := h.Dependency.DBClient.Document.RestoreDocument(c.Request.Context(), &entity.Document{ID: payload.ID})
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	response := &Response{
+		ID:           document.ID,
+		CategoryID:   document.CategoryID,
+		OriginalName: document.OriginalName,
+		Name:         document.Name,
+		Type:         document.Type,
+		Size:         document.Size,
+		CreatedAt:    document.CreatedAt.Format(time.RFC3339),
+	}
+
+	c.Status(http.StatusOK)
+	presenter.NewSuccessPresenter(c, response, "success.restore_document").JSON()
+}
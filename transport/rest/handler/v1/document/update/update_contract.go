@@ -0,0 +1,19 @@
+package update
+
+type Request struct {
+	ID           string `uri:"id" json:"-"`
+	CategoryID   string `json:"category_id"`
+	OriginalName string `json:"original_name"`
+}
+
+type Response struct {
+	ID           string `json:"id"`
+	CategoryID   string `json:"category_id"`
+	OriginalName string `json:"original_name"`
+	Name         string `json:"name"`
+	Path         string `json:"path"`
+	Type         string `json:"type"`
+	Size         int64  `json:"size"`
+	Version      int64  `json:"version"`
+	CreatedAt    string `json:"created_at"`
+}
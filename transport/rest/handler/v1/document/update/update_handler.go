@@ -0,0 +1,98 @@
+package update
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"micro/domain/entity"
+	"micro/pkg/validator"
+	"micro/transport/rest/dependency"
+	"micro/transport/rest/presenter"
+	"net/http"
+	"time"
+)
+
+// Handler holds the dependency.
+type Handler struct {
+	Dependency *dependency.Dependency
+}
+
+// UpdateDocument will handle update document request.
+// The document is moved to another category when the category id is set, the storage usage is moved along.
+// The If-Match header takes the ETag of the document, the update is refused when the document was changed since.
+// @Summary Uses to update document request
+// @Description Document.
+// @Tags Document API
+// @Accept  json
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Param If-Match header string false "Fill with the ETag of the document"
+// @Param payload body update.Request true "Document"
+// @Success 200 {object} presenter.Success{data=update.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 401 {object} presenter.Error
+// @Failure 403 {object} presenter.Error
+// @Failure 404 {object} presenter.Error
+// @Failure 409 {object} presenter.Error
+// @Failure 412 {object} presenter.Error
+// @Failure 422 {object} presenter.Error
+// @Failure 429 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/documents/:id [put]
+func (h *Handler) UpdateDocument(c *gin.Context) {
+	var payload Request
+	err := c.ShouldBindUri(&payload)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("error.common.bad_request"))
+		return
+	}
+
+	err = c.ShouldBindJSON(&payload)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("error.common.bad_request"))
+		return
+	}
+
+	version, err := presenter.ParseIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusPreconditionFailed, err)
+		return
+	}
+
+	validation := validator.New()
+	validation.
+		Set("category_id", payload.CategoryID, validation.AddRule().IsUUID().Apply()).
+		Set("original_name", payload.OriginalName, validation.AddRule().Length(0, 255).Apply())
+
+	validationResult := validation.Validate()
+	if len(validationResult) > 0 {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, presenter.NewValidationError(errors.New("error.common.unprocessable_entity"), validationResult.ToErrorFieldList()))
+		return
+	}
+
+	document := &entity.Document{ID: payload.ID, Version: version}
+	err = h.Dependency.DBClient.Document.UpdateDocument(c.Request.Context(), document, &entity.Document{
+		CategoryID:   payload.CategoryID,
+		OriginalName: payload.OriginalName,
+	})
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	response := &Response{
+		ID:           document.ID,
+		CategoryID:   document.CategoryID,
+		OriginalName: document.OriginalName,
+		Name:         document.Name,
+		Path:         document.Path,
+		Type:         document.Type,
+		Size:         document.Size,
+		Version:      document.Version,
+		CreatedAt:    document.CreatedAt.Format(time.RFC3339),
+	}
+
+	c.Header("ETag", presenter.ETag(document.Version))
+	c.Status(http.StatusOK)
+	presenter.NewSuccessPresenter(c, response, "success.update_document").JSON()
+}
@@ -0,0 +1,19 @@
+package upload
+
+import "mime/multipart"
+
+type Request struct {
+	CategoryID string                `form:"category_id"`
+	File       *multipart.FileHeader `form:"file"`
+}
+
+type Response struct {
+	ID           string `json:"id"`
+	CategoryID   string `json:"category_id"`
+	OriginalName string `json:"original_name"`
+	Name         string `json:"name"`
+	Path         string `json:"path"`
+	Type         string `json:"type"`
+	Size         int64  `json:"size"`
+	CreatedAt    string `json:"created_at"`
+}
@@ -0,0 +1,138 @@
+package upload
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"micro/domain/entity"
+	"micro/persistence"
+	"micro/pkg/filestore/object"
+	"micro/pkg/validator"
+	"micro/transport/rest/dependency"
+	"micro/transport/rest/presenter"
+	"net/http"
+	"time"
+)
+
+// Handler holds the dependency.
+type Handler struct {
+	Dependency *dependency.Dependency
+}
+
+// UploadDocument will handle upload document request.
+// @Summary Uses to upload document request
+// @Description Document.
+// @Tags Document API
+// @Accept  multipart/form-data
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Param category_id formData string true "Document category id"
+// @Param file formData file true "Document file"
+// @Success 201 {object} presenter.Success{data=upload.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 401 {object} presenter.Error
+// @Failure 403 {object} presenter.Error
+// @Failure 404 {object} presenter.Error
+// @Failure 409 {object} presenter.Error
+// @Failure 413 {object} presenter.Error
+// @Failure 422 {object} presenter.Error
+// @Failure 429 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/documents [post]
+func (h *Handler) UploadDocument(c *gin.Context) {
+	var payload Request
+	err := c.ShouldBind(&payload)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("error.common.bad_request"))
+		return
+	}
+
+	validation := validator.New()
+	validation.
+		Set("category_id", payload.CategoryID, validation.AddRule().Required().IsUUID().Apply()).
+		Set("file", payload.File, validation.AddRule().Required().Apply())
+
+	validationResult := validation.Validate()
+	if len(validationResult) > 0 {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, presenter.NewValidationError(errors.New("error.common.unprocessable_entity"), validationResult.ToErrorFieldList()))
+		return
+	}
+
+	category, err := h.Dependency.DBClient.DocumentCategory.FindDocumentCategory(c.Request.Context(), &entity.DocumentCategory{ID: payload.CategoryID})
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	if category.Size > 0 && payload.File.Size > category.Size {
+		_ = c.AbortWithError(http.StatusRequestEntityTooLarge, errors.New("error.document.size_exceeded"))
+		return
+	}
+
+	// Check the quota before uploading the object, it is enforced again when the document is saved.
+	err = h.Dependency.DBClient.StorageUsage.CheckDocumentCategoryQuota(c.Request.Context(), category, payload.File.Size, 1)
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	metadata := object.NewFromMultipartFileHeader(payload.File, category.Slug,
+		object.WithID(uuid.New().String()),
+		object.WithPutMethod(object.DirectPut),
+		object.IncludeSlug(),
+		object.IncludeDate(),
+	)
+
+	_, err = h.Dependency.FileStorageClient.Driver.PutObject(metadata)
+	if err != nil {
+		h.Dependency.Logger.Log.Errorf("Unable to upload document, err: %v", err)
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	// The category is taken again and the document is saved with its storage usage in a single transaction,
+	// so the category deleted or filled up while the object was uploaded is not used.
+	var document *entity.Document
+	err = h.Dependency.DBClient.WithTransaction(c.Request.Context(), func(tx *persistence.DBClient) error {
+		current, err := tx.DocumentCategory.FindDocumentCategory(c.Request.Context(), &entity.DocumentCategory{ID: category.ID})
+		if err != nil {
+			return err
+		}
+
+		document, err = tx.Document.SaveDocument(c.Request.Context(), &entity.Document{
+			ID:           metadata.ID,
+			CategoryID:   current.ID,
+			OriginalName: metadata.OriginalName,
+			Name:         metadata.Filename(),
+			Path:         metadata.Filepath(),
+			Type:         metadata.ContentType,
+			Size:         metadata.Size,
+		})
+
+		return err
+	})
+	if err != nil {
+		errDelete := h.Dependency.FileStorageClient.Driver.DeleteObject(metadata.Filepath())
+		if errDelete != nil {
+			h.Dependency.Logger.Log.Errorf("Unable to delete uploaded object %s, err: %v", metadata.Filepath(), errDelete)
+		}
+
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	response := &Response{
+		ID:           document.ID,
+		CategoryID:   document.CategoryID,
+		OriginalName: document.OriginalName,
+		Name:         document.Name,
+		Path:         document.Path,
+		Type:         document.Type,
+		Size:         document.Size,
+		CreatedAt:    document.CreatedAt.Format(time.RFC3339),
+	}
+
+	c.Status(http.StatusCreated)
+	presenter.NewSuccessPresenter(c, response, "success.upload_document").JSON()
+}
@@ -0,0 +1,101 @@
+package create
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"micro/domain/entity"
+	"micro/pkg/util"
+	"micro/pkg/validator"
+	"micro/transport/rest/dependency"
+	"micro/transport/rest/presenter"
+	"net/http"
+	"time"
+)
+
+// Handler holds the dependency.
+type Handler struct {
+	Dependency *dependency.Dependency
+}
+
+// CreateCategory will handle create category request.
+// The size accepts a human readable size, e.g. 10MB, or a number of bytes.
+// The slug must not be taken by another category which is not deleted.
+// @Summary Uses to create category request
+// @Description Document category.
+// @Tags Document Category API
+// @Accept  json
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Param payload body create.Request true "Document category"
+// @Success 201 {object} presenter.Success{data=create.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 401 {object} presenter.Error
+// @Failure 403 {object} presenter.Error
+// @Failure 409 {object} presenter.Error
+// @Failure 422 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/document-categories [post]
+func (h *Handler) CreateCategory(c *gin.Context) {
+	var payload Request
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("error.common.bad_request"))
+		return
+	}
+
+	id := uuid.New().String()
+
+	validation := validator.New()
+	validation.
+		Set("slug", payload.Slug, validation.AddRule().Required().Length(1, 100).Apply()).
+		Set("name", payload.Name, validation.AddRule().Required().Length(1, 100).Apply()).
+		Set("size", payload.Size, validation.AddRule().Required().IsByteSize().Apply()).
+		Set("mime_types", payload.MimeTypes, validation.AddRule().Required().Length(1, 255).Apply()).
+		Set("description", payload.Description, validation.AddRule().Length(0, 255).Apply())
+
+	validationResult := validation.Validate()
+	if len(validationResult) > 0 {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, presenter.NewValidationError(errors.New("error.common.unprocessable_entity"), validationResult.ToErrorFieldList()))
+		return
+	}
+
+	size, _ := util.ParseByteSize(payload.Size)
+	category, err := h.Dependency.DBClient.DocumentCategory.SaveDocumentCategory(c.Request.Context(), &entity.DocumentCategory{
+		ID:                    id,
+		Slug:                  payload.Slug,
+		Name:                  payload.Name,
+		Description:           payload.Description,
+		MimeTypes:             payload.MimeTypes,
+		Size:                  int64(size),
+		PurgeDeletedAfterDays: payload.PurgeDeletedAfterDays,
+		ExpireActiveAfterDays: payload.ExpireActiveAfterDays,
+		QuotaBytes:            payload.QuotaBytes,
+		QuotaObjects:          payload.QuotaObjects,
+	})
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	response := &Response{
+		ID:                    category.ID,
+		Name:                  category.Name,
+		Slug:                  category.Slug,
+		Size:                  category.Size,
+		SizeFormatted:         category.SizeFormatted(),
+		MimeTypes:             category.MimeTypes,
+		Desc:                  category.Description,
+		PurgeDeletedAfterDays: category.PurgeDeletedAfterDays,
+		ExpireActiveAfterDays: category.ExpireActiveAfterDays,
+		QuotaBytes:            category.QuotaBytes,
+		QuotaObjects:          category.QuotaObjects,
+		Version:               category.Version,
+		CreatedAt:             category.CreatedAt.Format(time.RFC3339),
+	}
+
+	c.Header("ETag", presenter.ETag(category.Version))
+	c.Status(http.StatusCreated)
+	presenter.NewSuccessPresenter(c, response, "success.create_category").JSON()
+}
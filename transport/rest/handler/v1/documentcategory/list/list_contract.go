@@ -0,0 +1,16 @@
+package list
+
+type Response struct {
+	ID                    string `json:"id"`
+	Name                  string `json:"name"`
+	Slug                  string `json:"slug"`
+	Size                  int64  `json:"size"`
+	SizeFormatted         string `json:"size_formatted"`
+	MimeTypes             string `json:"mime_types"`
+	Desc                  string `json:"desc"`
+	PurgeDeletedAfterDays int    `json:"purge_deleted_after_days"`
+	ExpireActiveAfterDays int    `json:"expire_active_after_days"`
+	LegalHold             bool   `json:"legal_hold"`
+	CreatedAt             string `json:"created_at"`
+	DeletedAt             string `json:"deleted_at,omitempty"`
+}
@@ -0,0 +1,87 @@
+package list
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"micro/domain/entity"
+	"micro/pkg/parameter"
+	"micro/transport/rest/dependency"
+	"micro/transport/rest/presenter"
+	"net/http"
+	"time"
+)
+
+// Handler holds the dependency.
+type Handler struct {
+	Dependency *dependency.Dependency
+}
+
+// ListCategories will handle list categories request.
+// Soft-deleted categories are listed instead when the trashed query string is true.
+// @Summary Uses to list categories request
+// @Description Document category.
+// @Tags Document Category API
+// @Accept  json
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Param trashed query bool false "List soft-deleted categories only"
+// @Param sort query string false "Fill with comma separated fields, prefix the field with - to sort descending, e.g. -created_at,name"
+// @Param fields query string false "Fill with comma separated fields of the response, e.g. id,name"
+// @Param filter query string false "Fill with JSON filter expression, e.g. {\"or\":[{\"field\":\"name\",\"op\":\"equal\",\"value\":\"a\"}]}"
+// @Param cursor query string false "Fill with next_cursor or prev_cursor of the previous response"
+// @Param skip_total query bool false "Skip counting the total rows"
+// @Success 200 {object} presenter.Success{data=[]list.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 401 {object} presenter.Error
+// @Failure 403 {object} presenter.Error
+// @Failure 422 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/document-categories [get]
+func (h *Handler) ListCategories(c *gin.Context) {
+	var dataEntity entity.DocumentCategory
+
+	sqlParameters := parameter.NewHTTPParameters(c)
+	validationResult := sqlParameters.ValidateParameter(&dataEntity)
+	if len(validationResult) > 0 {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, presenter.NewValidationError(errors.New("error.common.unprocessable_entity"), validationResult.ToErrorFieldList()))
+		return
+	}
+
+	getDocumentCategories := h.Dependency.DBClient.DocumentCategory.GetDocumentCategories
+	if sqlParameters.Trashed {
+		getDocumentCategories = h.Dependency.DBClient.DocumentCategory.GetDeletedDocumentCategories
+	}
+
+	categories, meta, err := getDocumentCategories(c.Request.Context(), sqlParameters)
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	response := make([]*Response, 0, len(categories))
+	for _, category := range categories {
+		item := &Response{
+			ID:                    category.ID,
+			Name:                  category.Name,
+			Slug:                  category.Slug,
+			Size:                  category.Size,
+			SizeFormatted:         category.SizeFormatted(),
+			MimeTypes:             category.MimeTypes,
+			Desc:                  category.Description,
+			PurgeDeletedAfterDays: category.PurgeDeletedAfterDays,
+			ExpireActiveAfterDays: category.ExpireActiveAfterDays,
+			LegalHold:             category.LegalHold,
+			CreatedAt:             category.CreatedAt.Format(time.RFC3339),
+		}
+
+		if category.DeletedAt.Valid {
+			item.DeletedAt = category.DeletedAt.Time.Format(time.RFC3339)
+		}
+
+		response = append(response, item)
+	}
+
+	c.Status(http.StatusOK)
+	presenter.NewSuccessPresenter(c, response, "success.list_category").WithFields(sqlParameters.Fields).WithMeta(meta).JSON()
+}
@@ -0,0 +1,16 @@
+package restore
+
+type Request struct {
+	ID string `uri:"id"`
+}
+
+type Response struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Slug          string `json:"slug"`
+	Size          int64  `json:"size"`
+	SizeFormatted string `json:"size_formatted"`
+	MimeTypes     string `json:"mime_types"`
+	Desc          string `json:"desc"`
+	CreatedAt     string `json:"created_at"`
+}
@@ -0,0 +1,30 @@
+package update
+
+type Request struct {
+	ID                    string `uri:"id" json:"-"`
+	Slug                  string `json:"slug"`
+	Name                  string `json:"name"`
+	Size                  string `json:"size"`
+	MimeTypes             string `json:"mime_types"`
+	Description           string `json:"description"`
+	PurgeDeletedAfterDays int    `json:"purge_deleted_after_days"`
+	ExpireActiveAfterDays int    `json:"expire_active_after_days"`
+	QuotaBytes            int64  `json:"quota_bytes"`
+	QuotaObjects          int64  `json:"quota_objects"`
+}
+
+type Response struct {
+	ID                    string `json:"id"`
+	Name                  string `json:"name"`
+	Slug                  string `json:"slug"`
+	Size                  int64  `json:"size"`
+	SizeFormatted         string `json:"size_formatted"`
+	MimeTypes             string `json:"mime_types"`
+	Desc                  string `json:"desc"`
+	PurgeDeletedAfterDays int    `json:"purge_deleted_after_days"`
+	ExpireActiveAfterDays int    `json:"expire_active_after_days"`
+	QuotaBytes            int64  `json:"quota_bytes"`
+	QuotaObjects          int64  `json:"quota_objects"`
+	Version               int64  `json:"version"`
+	CreatedAt             string `json:"created_at"`
+}
@@ -0,0 +1,121 @@
+package update
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"micro/domain/entity"
+	"micro/pkg/util"
+	"micro/pkg/validator"
+	"micro/transport/rest/dependency"
+	"micro/transport/rest/presenter"
+	"net/http"
+	"time"
+)
+
+// Handler holds the dependency.
+type Handler struct {
+	Dependency *dependency.Dependency
+}
+
+// UpdateCategory will handle update category request.
+// The size accepts a human readable size, e.g. 10MB, or a number of bytes.
+// The slug must not be taken by another category which is not deleted.
+// The If-Match header takes the ETag of the category, the update is refused when the category was changed since.
+// @Summary Uses to update category request
+// @Description Document category.
+// @Tags Document Category API
+// @Accept  json
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Param If-Match header string false "Fill with the ETag of the category"
+// @Param payload body update.Request true "Document category"
+// @Success 200 {object} presenter.Success{data=update.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 401 {object} presenter.Error
+// @Failure 403 {object} presenter.Error
+// @Failure 404 {object} presenter.Error
+// @Failure 409 {object} presenter.Error
+// @Failure 412 {object} presenter.Error
+// @Failure 422 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/document-categories/:id [put]
+func (h *Handler) UpdateCategory(c *gin.Context) {
+	var payload Request
+	err := c.ShouldBindUri(&payload)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("error.common.bad_request"))
+		return
+	}
+
+	err = c.ShouldBindJSON(&payload)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("error.common.bad_request"))
+		return
+	}
+
+	version, err := presenter.ParseIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusPreconditionFailed, err)
+		return
+	}
+
+	validation := validator.New()
+	validation.
+		Set("slug", payload.Slug, validation.AddRule().Length(0, 100).Apply()).
+		Set("name", payload.Name, validation.AddRule().Length(0, 100).Apply()).
+		Set("size", payload.Size, validation.AddRule().IsByteSize().Apply()).
+		Set("mime_types", payload.MimeTypes, validation.AddRule().Length(0, 255).Apply()).
+		Set("description", payload.Description, validation.AddRule().Length(0, 255).Apply())
+
+	validationResult := validation.Validate()
+	if len(validationResult) > 0 {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, presenter.NewValidationError(errors.New("error.common.unprocessable_entity"), validationResult.ToErrorFieldList()))
+		return
+	}
+
+	category, err := h.Dependency.DBClient.DocumentCategory.FindDocumentCategory(c.Request.Context(), &entity.DocumentCategory{ID: payload.ID})
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	size, _ := util.ParseByteSize(payload.Size)
+	category, err = h.Dependency.DBClient.DocumentCategory.UpdateDocumentCategory(c.Request.Context(), &entity.DocumentCategory{
+		ID:                    category.ID,
+		Slug:                  payload.Slug,
+		Name:                  payload.Name,
+		Description:           payload.Description,
+		MimeTypes:             payload.MimeTypes,
+		Size:                  int64(size),
+		PurgeDeletedAfterDays: payload.PurgeDeletedAfterDays,
+		ExpireActiveAfterDays: payload.ExpireActiveAfterDays,
+		QuotaBytes:            payload.QuotaBytes,
+		QuotaObjects:          payload.QuotaObjects,
+		Version:               version,
+	})
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	response := &Response{
+		ID:                    category.ID,
+		Name:                  category.Name,
+		Slug:                  category.Slug,
+		Size:                  category.Size,
+		SizeFormatted:         category.SizeFormatted(),
+		MimeTypes:             category.MimeTypes,
+		Desc:                  category.Description,
+		PurgeDeletedAfterDays: category.PurgeDeletedAfterDays,
+		ExpireActiveAfterDays: category.ExpireActiveAfterDays,
+		QuotaBytes:            category.QuotaBytes,
+		QuotaObjects:          category.QuotaObjects,
+		Version:               category.Version,
+		CreatedAt:             category.CreatedAt.Format(time.RFC3339),
+	}
+
+	c.Header("ETag", presenter.ETag(category.Version))
+	c.Status(http.StatusOK)
+	presenter.NewSuccessPresenter(c, response, "success.update_category").JSON()
+}
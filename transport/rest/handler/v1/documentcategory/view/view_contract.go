@@ -5,31 +5,67 @@ type Request struct {
 }
 
 type Response struct {
-	ID        string  `json:"id"`
-	Name      string  `json:"name"`
-	Slug      string  `json:"slug"`
-	Size      float64 `json:"size"`
-	MimeTypes string  `json:"mime_types"`
-	Desc      string  `json:"desc"`
-	CreatedAt string  `json:"created_at"`
+	ID                    string `json:"id"`
+	Name                  string `json:"name"`
+	Slug                  string `json:"slug"`
+	Size                  int64  `json:"size"`
+	SizeFormatted         string `json:"size_formatted"`
+	MimeTypes             string `json:"mime_types"`
+	Desc                  string `json:"desc"`
+	PurgeDeletedAfterDays int    `json:"purge_deleted_after_days"`
+	ExpireActiveAfterDays int    `json:"expire_active_after_days"`
+	LegalHold             bool   `json:"legal_hold"`
+	LegalHoldReason       string `json:"legal_hold_reason"`
+	LegalHoldBy           string `json:"legal_hold_by"`
+	LegalHoldAt           string `json:"legal_hold_at"`
+	QuotaBytes            int64  `json:"quota_bytes"`
+	QuotaObjects          int64  `json:"quota_objects"`
+	UsedBytes             int64  `json:"used_bytes"`
+	UsedObjects           int64  `json:"used_objects"`
+	Version               int64  `json:"version"`
+	CreatedAt             string `json:"created_at"`
 }
 
 type ResponseWithoutCreatedAt struct {
-	ID        string  `json:"id"`
-	Name      string  `json:"name"`
-	Slug      string  `json:"slug"`
-	Size      float64 `json:"size"`
-	MimeTypes string  `json:"mime_types"`
-	Desc      string  `json:"desc"`
+	ID                    string `json:"id"`
+	Name                  string `json:"name"`
+	Slug                  string `json:"slug"`
+	Size                  int64  `json:"size"`
+	SizeFormatted         string `json:"size_formatted"`
+	MimeTypes             string `json:"mime_types"`
+	Desc                  string `json:"desc"`
+	PurgeDeletedAfterDays int    `json:"purge_deleted_after_days"`
+	ExpireActiveAfterDays int    `json:"expire_active_after_days"`
+	LegalHold             bool   `json:"legal_hold"`
+	LegalHoldReason       string `json:"legal_hold_reason"`
+	LegalHoldBy           string `json:"legal_hold_by"`
+	LegalHoldAt           string `json:"legal_hold_at"`
+	QuotaBytes            int64  `json:"quota_bytes"`
+	QuotaObjects          int64  `json:"quota_objects"`
+	UsedBytes             int64  `json:"used_bytes"`
+	UsedObjects           int64  `json:"used_objects"`
+	Version               int64  `json:"version"`
 }
 
 func (r *Response) WithoutCreatedAt() interface{} {
 	return &ResponseWithoutCreatedAt{
-		ID:        r.ID,
-		Name:      r.Name,
-		Slug:      r.Slug,
-		Size:      r.Size,
-		MimeTypes: r.MimeTypes,
-		Desc:      r.Desc,
+		ID:                    r.ID,
+		Name:                  r.Name,
+		Slug:                  r.Slug,
+		Size:                  r.Size,
+		SizeFormatted:         r.SizeFormatted,
+		MimeTypes:             r.MimeTypes,
+		Desc:                  r.Desc,
+		PurgeDeletedAfterDays: r.PurgeDeletedAfterDays,
+		ExpireActiveAfterDays: r.ExpireActiveAfterDays,
+		LegalHold:             r.LegalHold,
+		LegalHoldReason:       r.LegalHoldReason,
+		LegalHoldBy:           r.LegalHoldBy,
+		LegalHoldAt:           r.LegalHoldAt,
+		QuotaBytes:            r.QuotaBytes,
+		QuotaObjects:          r.QuotaObjects,
+		UsedBytes:             r.UsedBytes,
+		UsedObjects:           r.UsedObjects,
+		Version:               r.Version,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/gin-gonic/gin"
 	"micro/domain/entity"
+	"micro/pkg/parameter"
 	"micro/transport/rest/dependency"
 	"micro/transport/rest/presenter"
 	"net/http"
@@ -16,6 +17,7 @@ type Handler struct {
 }
 
 // ViewCategory will handle find category request.
+// The ETag header holds the version of the category, it is sent back by If-Match to update the category.
 // @Summary Uses to find category request
 // @Description Document category.
 // @Tags Document Category API
@@ -23,6 +25,7 @@ type Handler struct {
 // @Produce application/json
 // @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
 // @Param Set-Request-Id header string false "Fill with request id"
+// @Param fields query string false "Fill with comma separated fields of the response, e.g. id,name,created_at"
 // @Success 200 {object} presenter.Success{data=ping.Response}
 // @Failure 400 {object} presenter.Error
 // @Failure 401 {object} presenter.Error
@@ -39,22 +42,63 @@ func (h *Handler) ViewCategory(c *gin.Context) {
 		return
 	}
 
+	var dataEntity entity.DocumentCategory
+	fields := parameter.ParseFields(c.Query("fields"))
+	validationResult := parameter.ValidateFields(fields, &dataEntity)
+	if len(validationResult) > 0 {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, presenter.NewValidationError(errors.New("error.common.unprocessable_entity"), validationResult.ToErrorFieldList()))
+		return
+	}
+
 	category, err := h.Dependency.DBClient.DocumentCategory.FindDocumentCategory(c.Request.Context(), &entity.DocumentCategory{ID: payload.ID})
 	if err != nil {
-		_ = c.AbortWithError(http.StatusNotFound, errors.New("error.common.not_found"))
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	usage, err := h.Dependency.DBClient.StorageUsage.FindStorageUsage(c.Request.Context(), &entity.StorageUsage{
+		SubjectType: entity.StorageSubjectDocumentCategory,
+		SubjectID:   category.ID,
+	})
+	if err != nil {
+		presenter.AbortWithError(c, err)
 		return
 	}
 
 	response := &Response{
-		ID:        category.ID,
-		Name:      category.Name,
-		Slug:      category.Slug,
-		Size:      category.Size,
-		MimeTypes: category.MimeTypes,
-		Desc:      category.Description,
-		CreatedAt: category.CreatedAt.Format(time.RFC3339),
+		ID:                    category.ID,
+		Name:                  category.Name,
+		Slug:                  category.Slug,
+		Size:                  category.Size,
+		SizeFormatted:         category.SizeFormatted(),
+		MimeTypes:             category.MimeTypes,
+		Desc:                  category.Description,
+		PurgeDeletedAfterDays: category.PurgeDeletedAfterDays,
+		ExpireActiveAfterDays: category.ExpireActiveAfterDays,
+		LegalHold:             category.LegalHold,
+		LegalHoldReason:       category.LegalHoldReason,
+		LegalHoldBy:           category.LegalHoldBy,
+		QuotaBytes:            category.QuotaBytes,
+		QuotaObjects:          category.QuotaObjects,
+		UsedBytes:             usage.UsedBytes,
+		UsedObjects:           usage.UsedObjects,
+		Version:               category.Version,
+		CreatedAt:             category.CreatedAt.Format(time.RFC3339),
+	}
+
+	if category.LegalHoldAt != nil {
+		response.LegalHoldAt = category.LegalHoldAt.Format(time.RFC3339)
+	}
+
+	c.Header("ETag", presenter.ETag(category.Version))
+
+	// The created_at is only shown when it is requested by the fields.
+	if len(fields) == 0 {
+		c.Status(http.StatusOK)
+		presenter.NewSuccessPresenter(c, response.WithoutCreatedAt(), "success.view_category").JSON()
+		return
 	}
 
 	c.Status(http.StatusOK)
-	presenter.NewSuccessPresenter(c, response.WithoutCreatedAt(), "success.view_category").JSON()
+	presenter.NewSuccessPresenter(c, response, "success.view_category").WithFields(fields).JSON()
 }
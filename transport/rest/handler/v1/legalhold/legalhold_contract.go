@@ -0,0 +1,17 @@
+package legalhold
+
+type Request struct {
+	ID     string `uri:"id"`
+	Reason string `json:"reason"`
+	Actor  string `json:"actor"`
+}
+
+type Response struct {
+	ID          string `json:"id"`
+	SubjectType string `json:"subject_type"`
+	SubjectID   string `json:"subject_id"`
+	Action      string `json:"action"`
+	Reason      string `json:"reason"`
+	Actor       string `json:"actor"`
+	CreatedAt   string `json:"created_at"`
+}
@@ -0,0 +1,168 @@
+package legalhold
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"micro/domain/entity"
+	"micro/pkg/validator"
+	"micro/transport/rest/dependency"
+	"micro/transport/rest/presenter"
+	"net/http"
+	"time"
+)
+
+// Handler holds the dependency.
+type Handler struct {
+	Dependency *dependency.Dependency
+}
+
+// PlaceDocumentLegalHold will handle place legal hold on a document request.
+// @Summary Uses to place legal hold on a document
+// @Description Legal hold.
+// @Tags Legal Hold API
+// @Accept  json
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Success 200 {object} presenter.Success{data=legalhold.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 404 {object} presenter.Error
+// @Failure 409 {object} presenter.Error
+// @Failure 422 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/documents/:id/legal-hold [post]
+func (h *Handler) PlaceDocumentLegalHold(c *gin.Context) {
+	h.placeLegalHold(c, entity.LegalHoldSubjectDocument)
+}
+
+// ReleaseDocumentLegalHold will handle release legal hold of a document request.
+// @Summary Uses to release legal hold of a document
+// @Description Legal hold.
+// @Tags Legal Hold API
+// @Accept  json
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Success 200 {object} presenter.Success{data=legalhold.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 404 {object} presenter.Error
+// @Failure 409 {object} presenter.Error
+// @Failure 422 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/documents/:id/legal-hold [delete]
+func (h *Handler) ReleaseDocumentLegalHold(c *gin.Context) {
+	h.releaseLegalHold(c, entity.LegalHoldSubjectDocument)
+}
+
+// PlaceDocumentCategoryLegalHold will handle place legal hold on a document category request.
+// @Summary Uses to place legal hold on a document category
+// @Description Legal hold.
+// @Tags Legal Hold API
+// @Accept  json
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Success 200 {object} presenter.Success{data=legalhold.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 404 {object} presenter.Error
+// @Failure 409 {object} presenter.Error
+// @Failure 422 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/document-categories/:id/legal-hold [post]
+func (h *Handler) PlaceDocumentCategoryLegalHold(c *gin.Context) {
+	h.placeLegalHold(c, entity.LegalHoldSubjectDocumentCategory)
+}
+
+// ReleaseDocumentCategoryLegalHold will handle release legal hold of a document category request.
+// @Summary Uses to release legal hold of a document category
+// @Description Legal hold.
+// @Tags Legal Hold API
+// @Accept  json
+// @Produce application/json
+// @Param Accept-Language header string false "Fill with language code" Enums(en, id) default(id)
+// @Param Set-Request-Id header string false "Fill with request id"
+// @Success 200 {object} presenter.Success{data=legalhold.Response}
+// @Failure 400 {object} presenter.Error
+// @Failure 404 {object} presenter.Error
+// @Failure 409 {object} presenter.Error
+// @Failure 422 {object} presenter.Error
+// @Failure 500 {object} presenter.Error
+// @Router /api/v1/document-categories/:id/legal-hold [delete]
+func (h *Handler) ReleaseDocumentCategoryLegalHold(c *gin.Context) {
+	h.releaseLegalHold(c, entity.LegalHoldSubjectDocumentCategory)
+}
+
+func (h *Handler) placeLegalHold(c *gin.Context, subjectType string) {
+	payload, ok := bindRequest(c)
+	if !ok {
+		return
+	}
+
+	audit, err := h.Dependency.DBClient.LegalHold.PlaceLegalHold(c.Request.Context(), &entity.LegalHoldAudit{
+		SubjectType: subjectType,
+		SubjectID:   payload.ID,
+		Reason:      payload.Reason,
+		Actor:       payload.Actor,
+	})
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+	presenter.NewSuccessPresenter(c, toResponse(audit), "success.place_legal_hold").JSON()
+}
+
+func (h *Handler) releaseLegalHold(c *gin.Context, subjectType string) {
+	payload, ok := bindRequest(c)
+	if !ok {
+		return
+	}
+
+	audit, err := h.Dependency.DBClient.LegalHold.ReleaseLegalHold(c.Request.Context(), &entity.LegalHoldAudit{
+		SubjectType: subjectType,
+		SubjectID:   payload.ID,
+		Reason:      payload.Reason,
+		Actor:       payload.Actor,
+	})
+	if err != nil {
+		presenter.AbortWithError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+	presenter.NewSuccessPresenter(c, toResponse(audit), "success.release_legal_hold").JSON()
+}
+
+func bindRequest(c *gin.Context) (*Request, bool) {
+	var payload Request
+	if c.ShouldBindUri(&payload) != nil || c.ShouldBindJSON(&payload) != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("error.common.bad_request"))
+		return nil, false
+	}
+
+	validation := validator.New()
+	validation.
+		Set("id", payload.ID, validation.AddRule().Required().IsUUID().Apply()).
+		Set("reason", payload.Reason, validation.AddRule().Required().Length(1, 255).Apply()).
+		Set("actor", payload.Actor, validation.AddRule().Required().Length(1, 100).Apply())
+	validationResult := validation.Validate()
+	if len(validationResult) > 0 {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, presenter.NewValidationError(errors.New("error.common.unprocessable_entity"), validationResult.ToErrorFieldList()))
+		return nil, false
+	}
+
+	return &payload, true
+}
+
+func toResponse(audit *entity.LegalHoldAudit) *Response {
+	return &Response{
+		ID:          audit.ID,
+		SubjectType: audit.SubjectType,
+		SubjectID:   audit.SubjectID,
+		Action:      audit.Action,
+		Reason:      audit.Reason,
+		Actor:       audit.Actor,
+		CreatedAt:   audit.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -1,10 +1,13 @@
 package middleware
 
 import (
+	"errors"
 	"github.com/gin-gonic/gin"
 	"micro/pkg/configurator"
+	"micro/pkg/exception"
 	"micro/pkg/logger"
 	"micro/transport/rest/presenter"
+	"net/http"
 )
 
 // Error handle error.
@@ -34,9 +37,28 @@ func (e *Error) ErrorHandler() gin.HandlerFunc {
 		err := c.Errors.Last().Err
 		c.Errors = c.Errors[:0]
 
-		if e.Config.AppEnvironment == "production" && c.Writer.Status() == 500 {
-			c.JSON(c.Writer.Status(), &presenter.Error{
-				Code:    c.Writer.Status(),
+		var data []*presenter.ErrorData
+		var errWithData *presenter.ErrorWithData
+		if errors.As(err, &errWithData) {
+			data = errWithData.Data
+		}
+
+		// The domain error and the error which is aborted without status are presented by the kind of the domain
+		// error, so the status and the message of the repository errors are the same as on gRPC.
+		status := c.Writer.Status()
+		var errDomain *exception.Error
+		if errors.As(err, &errDomain) || status < http.StatusBadRequest {
+			errDomain = exception.FromError(err)
+			status = errDomain.Kind.HTTPStatus()
+			err = errDomain
+			if data == nil {
+				data = presenter.NewDomainErrorData(errDomain)
+			}
+		}
+
+		if e.Config.AppEnvironment == "production" && status == http.StatusInternalServerError {
+			c.JSON(status, &presenter.Error{
+				Code:    status,
 				Data:    nil,
 				Message: err.Error(),
 			})
@@ -44,9 +66,9 @@ func (e *Error) ErrorHandler() gin.HandlerFunc {
 			return
 		}
 
-		c.JSON(c.Writer.Status(), &presenter.Error{
-			Code:    c.Writer.Status(),
-			Data:    nil,
+		c.JSON(status, &presenter.Error{
+			Code:    status,
+			Data:    data,
 			Message: err.Error(),
 		})
 	}
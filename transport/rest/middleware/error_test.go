@@ -0,0 +1,128 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"micro/domain/repository"
+	"micro/pkg/configurator"
+	"micro/pkg/exception"
+	"micro/transport/rest/middleware"
+	"micro/transport/rest/presenter"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// amount return the pointer of the quota amount.
+func amount(value int64) *int64 {
+	return &value
+}
+
+func serveError(t *testing.T, environment string, handler gin.HandlerFunc) (int, *presenter.Error) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.NewError(&configurator.Config{AppEnvironment: environment}, nil).ErrorHandler())
+	router.GET("/", handler)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	var response presenter.Error
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+
+	return recorder.Code, &response
+}
+
+func TestErrorHandlerDomainError(t *testing.T) {
+	testCases := []struct {
+		name    string
+		err     error
+		status  int
+		message string
+		data    []*presenter.ErrorData
+	}{
+		{
+			name:    "not found",
+			err:     exception.Wrap(exception.KindNotFound, exception.ErrNotFound.Message, errors.New("record not found")),
+			status:  http.StatusNotFound,
+			message: "error.common.not_found",
+		},
+		{
+			name:    "conflict",
+			err:     &repository.ConflictError{Field: "slug"},
+			status:  http.StatusConflict,
+			message: "error.common.conflict",
+			data:    []*presenter.ErrorData{{Field: "slug", Description: "validation.error.already_exists"}},
+		},
+		{
+			name: "quota exceeded",
+			err: &repository.QuotaExceededError{Violations: []repository.QuotaViolation{
+				{SubjectType: "document_category", SubjectID: "1", Quota: "bytes", Limit: 10, Used: 8, Requested: 4},
+			}},
+			status:  http.StatusTooManyRequests,
+			message: "error.storage_quota.exceeded",
+			data: []*presenter.ErrorData{
+				{
+					Quota:       "bytes",
+					Field:       "document_category:1",
+					Description: "bytes quota exceeded: used 8, requested 4, limit 10",
+					Limit:       amount(10),
+					Used:        amount(8),
+					Requested:   amount(4),
+				},
+			},
+		},
+		{
+			name:    "version conflict",
+			err:     repository.ErrVersionConflict,
+			status:  http.StatusPreconditionFailed,
+			message: "error.common.version_conflict",
+		},
+		{
+			name:    "canceled",
+			err:     fmt.Errorf("query: %w", context.Canceled),
+			status:  499,
+			message: "error.common.canceled",
+		},
+		{
+			name:    "unknown",
+			err:     errors.New("dial tcp 10.0.0.1:5432: connection refused"),
+			status:  http.StatusInternalServerError,
+			message: "error.common.internal_server_error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, response := serveError(t, "development", func(c *gin.Context) {
+				presenter.AbortWithError(c, tc.err)
+			})
+
+			assert.Equal(t, tc.status, status)
+			assert.Equal(t, tc.status, response.Code)
+			assert.Equal(t, tc.message, response.Message)
+			assert.Equal(t, tc.data, response.Data)
+		})
+	}
+}
+
+func TestErrorHandlerStatusError(t *testing.T) {
+	status, response := serveError(t, "development", func(c *gin.Context) {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("error.common.bad_request"))
+	})
+
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, "error.common.bad_request", response.Message)
+
+	status, response = serveError(t, "production", func(c *gin.Context) {
+		presenter.AbortWithError(c, &repository.ConflictError{Field: "slug"})
+	})
+
+	assert.Equal(t, http.StatusConflict, status)
+	assert.Len(t, response.Data, 1)
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"micro/pkg/provider/connection"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HeaderReadPrimary is the header uses to force the reads of the request to the primary database.
+const HeaderReadPrimary = "X-Read-Primary"
+
+// ReadPrimary will force the reads of the request to the primary database when X-Read-Primary header is true,
+// so the client can read its own writes which are not replicated yet.
+func ReadPrimary() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if forced, _ := strconv.ParseBool(c.GetHeader(HeaderReadPrimary)); forced {
+			c.Request = c.Request.WithContext(connection.WithPrimary(c.Request.Context()))
+		}
+
+		c.Next()
+	}
+}
@@ -1,5 +1,11 @@
 package presenter
 
+import (
+	"micro/pkg/exception"
+
+	"github.com/gin-gonic/gin"
+)
+
 // Error is error output presenter.
 type Error struct {
 	Code             int          `json:"code"`
@@ -8,9 +14,88 @@ type Error struct {
 	ErrorTracingCode string       `json:"error_code,omitempty"`
 }
 
-// ErrorData holds error data.
+// ErrorData holds error data, the limit, used and requested amounts are set for the quota violation.
 type ErrorData struct {
 	Quota       string `json:"quota,omitempty"`
 	Field       string `json:"field,omitempty"`
 	Description string `json:"description,omitempty"`
+	Limit       *int64 `json:"limit,omitempty"`
+	Used        *int64 `json:"used,omitempty"`
+	Requested   *int64 `json:"requested,omitempty"`
+}
+
+// ErrorWithData is an error which carries the error data to be rendered by the error middleware.
+type ErrorWithData struct {
+	Err  error
+	Data []*ErrorData
+}
+
+// NewErrorWithData will initialize a new ErrorWithData.
+func NewErrorWithData(err error, data []*ErrorData) *ErrorWithData {
+	return &ErrorWithData{
+		Err:  err,
+		Data: data,
+	}
+}
+
+// Error return the message of the wrapped error.
+func (e *ErrorWithData) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap return the wrapped error.
+func (e *ErrorWithData) Unwrap() error {
+	return e.Err
+}
+
+// NewValidationError will initialize a new ErrorWithData which carries the field violations of the validation.
+func NewValidationError(err error, fields exception.ErrorHTTPFieldList) *ErrorWithData {
+	data := make([]*ErrorData, 0, len(fields))
+	for _, field := range fields {
+		data = append(data, &ErrorData{
+			Field:       field.Field,
+			Description: field.Msg,
+		})
+	}
+
+	return NewErrorWithData(err, data)
+}
+
+// NewDomainErrorData return the error data of the details of the domain error, the scope of the quota violation
+// is presented as the quota with its limit, used and requested amounts.
+func NewDomainErrorData(errDomain *exception.Error) []*ErrorData {
+	var data []*ErrorData
+	for _, detail := range errDomain.Details {
+		errorData := &ErrorData{
+			Field:       detail.Field,
+			Description: detail.Msg,
+		}
+		if errDomain.Kind == exception.KindResourceExhausted {
+			errorData.Quota = detail.Scope
+			errorData.Limit = quotaAmount(detail.Data, "limit")
+			errorData.Used = quotaAmount(detail.Data, "used")
+			errorData.Requested = quotaAmount(detail.Data, "requested")
+		}
+
+		data = append(data, errorData)
+	}
+
+	return data
+}
+
+// quotaAmount return the amount of the key of the quota violation data, nil when it is not set.
+func quotaAmount(data map[string]interface{}, key string) *int64 {
+	amount, ok := data[key].(int64)
+	if !ok {
+		return nil
+	}
+
+	return &amount
+}
+
+// AbortWithError abort the request with the domain error of err, the error middleware presents it with the HTTP
+// status of its kind. The error which is not a domain error is presented as internal server error.
+func AbortWithError(c *gin.Context, err error) {
+	_ = c.Error(exception.FromError(err))
+	c.Abort()
 }
@@ -0,0 +1,38 @@
+package presenter
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrPreconditionFailed is returned when the If-Match header is not an entity tag made by ETag, so it can never
+// match a version of the resource.
+var ErrPreconditionFailed = errors.New("error.common.precondition_failed")
+
+// ETag return the strong entity tag of the version, e.g. "3".
+func ETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ParseIfMatch return the version of the If-Match header, the zero version is returned when the header is empty
+// or "*" so the update is not conditional. ErrPreconditionFailed is returned when the header is not a single
+// strong entity tag made by ETag, because it can never match the current version.
+func ParseIfMatch(header string) (int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	unquoted, err := strconv.Unquote(header)
+	if err != nil || !strings.HasPrefix(header, `"`) {
+		return 0, ErrPreconditionFailed
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, ErrPreconditionFailed
+	}
+
+	return version, nil
+}
@@ -0,0 +1,29 @@
+package presenter_test
+
+import (
+	"micro/transport/rest/presenter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresenterETag(t *testing.T) {
+	assert.Equal(t, `"3"`, presenter.ETag(3))
+
+	version, err := presenter.ParseIfMatch(presenter.ETag(3))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), version)
+}
+
+func TestPresenterParseIfMatch(t *testing.T) {
+	for _, header := range []string{"", "*", " * "} {
+		version, err := presenter.ParseIfMatch(header)
+		assert.NoError(t, err)
+		assert.Zero(t, version)
+	}
+
+	for _, header := range []string{`3`, `W/"3"`, `"a"`, `"0"`, `"1", "2"`, "`3`"} {
+		_, err := presenter.ParseIfMatch(header)
+		assert.ErrorIs(t, err, presenter.ErrPreconditionFailed, header)
+	}
+}
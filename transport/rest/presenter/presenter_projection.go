@@ -0,0 +1,61 @@
+package presenter
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// WithFields will shape the data of Success to hold only the fields, the data is kept as is when the fields are empty.
+func (s *Success) WithFields(fields []string) *Success {
+	s.Data = Project(s.Data, fields)
+
+	return s
+}
+
+// Project return the data holds only the fields, the data can be a single object or a list of objects.
+// The data is returned as is when the fields are empty. The numbers are kept as json.Number, so the int64 above 2^53
+// is not rounded.
+func Project(data interface{}, fields []string) interface{} {
+	if len(fields) == 0 {
+		return data
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	if err = decoder.Decode(&decoded); err != nil {
+		return data
+	}
+
+	switch value := decoded.(type) {
+	case []interface{}:
+		for i, item := range value {
+			value[i] = projectObject(item, fields)
+		}
+		return value
+	default:
+		return projectObject(value, fields)
+	}
+}
+
+// projectObject remove the keys of the object which are not listed on the fields.
+func projectObject(object interface{}, fields []string) interface{} {
+	m, ok := object.(map[string]interface{})
+	if !ok {
+		return object
+	}
+
+	projected := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		if value, ok := m[field]; ok {
+			projected[field] = value
+		}
+	}
+
+	return projected
+}
@@ -0,0 +1,32 @@
+package presenter_test
+
+import (
+	"encoding/json"
+	"micro/transport/rest/presenter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type projectionResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
+func TestPresenterProject(t *testing.T) {
+	data := []*projectionResponse{
+		{ID: "1", Name: "a", Size: 1<<53 + 1},
+		{ID: "2", Name: "b", Size: 1<<62 + 1},
+	}
+
+	assert.Equal(t, data, presenter.Project(data, nil))
+
+	encoded, err := json.Marshal(presenter.Project(data, []string{"id", "size"}))
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"id":"1","size":9007199254740993},{"id":"2","size":4611686018427387905}]`, string(encoded))
+
+	encoded, err = json.Marshal(presenter.Project(data[0], []string{"name"}))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"a"}`, string(encoded))
+}
@@ -8,7 +8,16 @@ import (
 	"micro/pkg/logger"
 	"micro/transport/rest/dependency"
 	"micro/transport/rest/handler/ping"
+	documentList "micro/transport/rest/handler/v1/document/list"
+	documentRestore "micro/transport/rest/handler/v1/document/restore"
+	documentUpdate "micro/transport/rest/handler/v1/document/update"
+	documentUpload "micro/transport/rest/handler/v1/document/upload"
+	documentCategoryCreate "micro/transport/rest/handler/v1/documentcategory/create"
+	documentCategoryList "micro/transport/rest/handler/v1/documentcategory/list"
+	documentCategoryRestore "micro/transport/rest/handler/v1/documentcategory/restore"
+	documentCategoryUpdate "micro/transport/rest/handler/v1/documentcategory/update"
 	"micro/transport/rest/handler/v1/documentcategory/view"
+	"micro/transport/rest/handler/v1/legalhold"
 	"micro/transport/rest/middleware"
 	"net/http"
 	"strings"
@@ -44,6 +53,7 @@ func (r *Router) Init() *gin.Engine {
 	errorMiddleware := middleware.NewError(r.config, r.logger)
 
 	e.Use(errorMiddleware.ErrorHandler())
+	e.Use(middleware.ReadPrimary())
 
 	dep := &dependency.Dependency{
 		Config:            r.config,
@@ -55,6 +65,15 @@ func (r *Router) Init() *gin.Engine {
 
 	pingHandler := &ping.Handler{Dependency: dep}
 	documentCategory := &view.Handler{Dependency: dep}
+	documentCategoryCreateHandler := &documentCategoryCreate.Handler{Dependency: dep}
+	documentCategoryListHandler := &documentCategoryList.Handler{Dependency: dep}
+	documentCategoryRestoreHandler := &documentCategoryRestore.Handler{Dependency: dep}
+	documentCategoryUpdateHandler := &documentCategoryUpdate.Handler{Dependency: dep}
+	documentListHandler := &documentList.Handler{Dependency: dep}
+	documentRestoreHandler := &documentRestore.Handler{Dependency: dep}
+	documentUpdateHandler := &documentUpdate.Handler{Dependency: dep}
+	documentUploadHandler := &documentUpload.Handler{Dependency: dep}
+	legalHold := &legalhold.Handler{Dependency: dep}
 
 	v1 := e.Group("/api/v1", func(c *gin.Context) {
 		if strings.Contains(c.Request.Referer(), "#") {
@@ -62,7 +81,19 @@ func (r *Router) Init() *gin.Engine {
 			return
 		}
 	})
+	v1.GET("/document-categories", documentCategoryListHandler.ListCategories)
+	v1.POST("/document-categories", documentCategoryCreateHandler.CreateCategory)
 	v1.GET("/document-categories/:id", documentCategory.ViewCategory)
+	v1.PUT("/document-categories/:id", documentCategoryUpdateHandler.UpdateCategory)
+	v1.POST("/document-categories/:id/restore", documentCategoryRestoreHandler.RestoreCategory)
+	v1.POST("/document-categories/:id/legal-hold", legalHold.PlaceDocumentCategoryLegalHold)
+	v1.DELETE("/document-categories/:id/legal-hold", legalHold.ReleaseDocumentCategoryLegalHold)
+	v1.GET("/documents", documentListHandler.ListDocuments)
+	v1.POST("/documents", documentUploadHandler.UploadDocument)
+	v1.PUT("/documents/:id", documentUpdateHandler.UpdateDocument)
+	v1.POST("/documents/:id/restore", documentRestoreHandler.RestoreDocument)
+	v1.POST("/documents/:id/legal-hold", legalHold.PlaceDocumentLegalHold)
+	v1.DELETE("/documents/:id/legal-hold", legalHold.ReleaseDocumentLegalHold)
 
 	e.GET("/ping", pingHandler.Ping)
 
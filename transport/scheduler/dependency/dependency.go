@@ -0,0 +1,15 @@
+package dependency
+
+import (
+	"micro/persistence"
+	"micro/pkg/configurator"
+	"micro/pkg/logger"
+)
+
+// Dependency holds all dependency to run the scheduler transport.
+type Dependency struct {
+	Config            *configurator.Config
+	Logger            *logger.Logger
+	DBClient          *persistence.DBClient
+	FileStorageClient *persistence.FileStorageClient
+}
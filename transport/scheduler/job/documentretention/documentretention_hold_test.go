@@ -0,0 +1,74 @@
+package documentretention
+
+import (
+	"context"
+	"micro/domain/entity"
+	"micro/domain/factory"
+	"micro/domain/registry"
+	"micro/persistence"
+	"micro/pkg/configurator"
+	"micro/pkg/filestore/driver/memory"
+	"micro/pkg/logger"
+	"micro/pkg/provider/connection"
+	"micro/transport/scheduler/dependency"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentRetentionPurgeKeepsDocumentHeldAfterFetch(t *testing.T) {
+	ctx := context.Background()
+
+	config := &configurator.Config{TestMode: true, MaxIdleCons: 2, MaxOpenCons: 4}
+	config.DBTestConfig = configurator.DBTestConfig{DBDriver: "sqlite", DBName: filepath.Join(t.TempDir(), "micro_test.db")}
+
+	db, err := connection.NewDBConnection(config)
+	require.NoError(t, err)
+	require.NoError(t, registry.NewRegistry().AutoMigrate(db))
+
+	dbClient := persistence.NewDBService(db)
+	driver := memory.NewDriver()
+	f := factory.New(dbClient, driver)
+
+	category := f.DocumentCategory().WithRetention(0, 1).MustCreate(t)
+	document := f.Document().WithCategory(category).MustCreate(t)
+
+	// The category is held after its documents are fetched, before they are purged.
+	fetch := func(ctx context.Context, r *entity.Document, before time.Time, excludeIDs []string, limit int) (entity.Documents, error) {
+		documents, err := dbClient.Document.GetExpiredDocuments(ctx, r, before, excludeIDs, limit)
+		if err != nil || len(documents) == 0 {
+			return documents, err
+		}
+
+		_, err = dbClient.LegalHold.PlaceLegalHold(ctx, &entity.LegalHoldAudit{
+			SubjectType: entity.LegalHoldSubjectDocumentCategory,
+			SubjectID:   category.ID,
+			Reason:      "litigation",
+			Actor:       "legal",
+		})
+
+		return documents, err
+	}
+
+	handler := &Handler{Dependency: &dependency.Dependency{
+		Config:            config,
+		Logger:            logger.New(logger.NewDevelopmentConfig()),
+		DBClient:          dbClient,
+		FileStorageClient: persistence.NewFileStoreService(driver),
+	}}
+	require.NoError(t, handler.purge(ctx, category, entity.PurgeReasonExpired, time.Now().Add(time.Hour), fetch))
+
+	assert.True(t, driver.HasObject(document.Path))
+
+	_, err = dbClient.Document.FindDocument(ctx, document)
+	assert.NoError(t, err)
+
+	var reports entity.DocumentPurgeReports
+	require.NoError(t, db.Find(&reports).Error)
+	require.Len(t, reports, 1)
+	assert.Zero(t, reports[0].TotalPurged)
+	assert.Equal(t, int64(1), reports[0].TotalFailed)
+}
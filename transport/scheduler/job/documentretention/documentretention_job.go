@@ -0,0 +1,124 @@
+package documentretention
+
+import (
+	"context"
+	"micro/domain/entity"
+	"micro/persistence"
+	"micro/transport/scheduler/dependency"
+	"strings"
+	"time"
+)
+
+const defaultBatchSize = 100
+
+// Handler is a struct represent itself.
+type Handler struct {
+	Dependency *dependency.Dependency
+}
+
+// fetchFunc represent a repository function uses to fetch the documents need to be purged, except the excluded ones.
+type fetchFunc func(ctx context.Context, r *entity.Document, before time.Time, excludeIDs []string, limit int) (entity.Documents, error)
+
+// Purge will permanently delete the documents and the objects on the file storage based on
+// the retention policy of each category, then write a purge report for each category and reason.
+func (h *Handler) Purge(ctx context.Context) error {
+	categories, err := h.Dependency.DBClient.DocumentCategory.GetDocumentCategoriesWithRetention(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, category := range categories {
+		if before, ok := category.PurgeDeletedBefore(now); ok {
+			err = h.purge(ctx, category, entity.PurgeReasonDeleted, before, h.Dependency.DBClient.Document.GetDeletedDocumentsBefore)
+			if err != nil {
+				return err
+			}
+		}
+
+		if before, ok := category.ExpireActiveBefore(now); ok {
+			err = h.purge(ctx, category, entity.PurgeReasonExpired, before, h.Dependency.DBClient.Document.GetExpiredDocuments)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) purge(ctx context.Context, category *entity.DocumentCategory, reason string, before time.Time, fetch fetchFunc) error {
+	var failedDocumentIDs []string
+
+	report := &entity.DocumentPurgeReport{
+		CategoryID: category.ID,
+		Reason:     reason,
+		Cutoff:     before,
+		StartedAt:  time.Now(),
+	}
+
+	batchSize := h.Dependency.Config.RetentionPurgeBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	for {
+		// The failed documents are excluded, so they never fill a batch and hide the next documents.
+		documents, err := fetch(ctx, &entity.Document{CategoryID: category.ID}, before, failedDocumentIDs, batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, document := range documents {
+			errPurge := h.purgeDocument(ctx, document)
+			if errPurge != nil {
+				h.Dependency.Logger.Log.Errorf("Unable to purge document %s, err: %v", document.ID, errPurge)
+				failedDocumentIDs = append(failedDocumentIDs, document.ID)
+				report.TotalFailed++
+				continue
+			}
+
+			report.TotalPurged++
+			report.PurgedBytes += document.Size
+		}
+
+		// Stop when the last batch is not full, the failed documents will be retried on the next run.
+		if len(documents) < batchSize {
+			break
+		}
+	}
+
+	if report.TotalPurged == 0 && report.TotalFailed == 0 {
+		return nil
+	}
+
+	report.FailedDocumentIDs = strings.Join(failedDocumentIDs, ",")
+	report.FinishedAt = time.Now()
+
+	_, err := h.Dependency.DBClient.DocumentPurgeReport.SaveDocumentPurgeReport(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	h.Dependency.Logger.Log.Infof("Purged %d documents (%d failed) of category %s with reason %s", report.TotalPurged, report.TotalFailed, category.Slug, reason)
+
+	return nil
+}
+
+// purgeDocument purge the row first, it checks the legal hold and locks the row until the transaction ends, so the
+// object of the document held after the batch is fetched is never deleted. The object is deleted before the
+// transaction is committed, so the row is kept and retried when the object cannot be deleted.
+func (h *Handler) purgeDocument(ctx context.Context, document *entity.Document) error {
+	return h.Dependency.DBClient.WithTransaction(ctx, func(tx *persistence.DBClient) error {
+		err := tx.Document.PurgeDocument(ctx, document)
+		if err != nil {
+			return err
+		}
+
+		if document.Path == "" {
+			return nil
+		}
+
+		return h.Dependency.FileStorageClient.Driver.DeleteObject(document.Path)
+	})
+}
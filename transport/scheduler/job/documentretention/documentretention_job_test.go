@@ -0,0 +1,85 @@
+package documentretention_test
+
+import (
+	"context"
+	"errors"
+	"micro/domain/entity"
+	"micro/domain/factory"
+	"micro/domain/registry"
+	"micro/persistence"
+	"micro/pkg/configurator"
+	"micro/pkg/filestore/driver/memory"
+	"micro/pkg/logger"
+	"micro/pkg/provider/connection"
+	"micro/transport/scheduler/dependency"
+	"micro/transport/scheduler/job/documentretention"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingDriver is the in-memory driver which fails to delete the objects on the failing paths.
+type failingDriver struct {
+	*memory.Driver
+	failingPaths map[string]bool
+}
+
+func (d *failingDriver) DeleteObject(objectPath string) error {
+	if d.failingPaths[objectPath] {
+		return errors.New("object cannot be deleted")
+	}
+
+	return d.Driver.DeleteObject(objectPath)
+}
+
+func TestDocumentRetentionPurgeSkipsFailedDocuments(t *testing.T) {
+	ctx := context.Background()
+
+	config := &configurator.Config{TestMode: true, MaxIdleCons: 2, MaxOpenCons: 4}
+	config.DBTestConfig = configurator.DBTestConfig{DBDriver: "sqlite", DBName: filepath.Join(t.TempDir(), "micro_test.db")}
+	config.RetentionPurgeBatchSize = 2
+
+	db, err := connection.NewDBConnection(config)
+	require.NoError(t, err)
+	require.NoError(t, registry.NewRegistry().AutoMigrate(db))
+
+	dbClient := persistence.NewDBService(db)
+	driver := &failingDriver{Driver: memory.NewDriver(), failingPaths: map[string]bool{}}
+	f := factory.New(dbClient, driver)
+
+	// The failed documents are the oldest, so they fill the first batch.
+	category := f.DocumentCategory().WithRetention(0, 1).MustCreate(t)
+	var documents []*entity.Document
+	for i := 3; i > 0; i-- {
+		document := f.Document().WithCategory(category).MustCreate(t)
+		createdAt := time.Now().Add(-time.Duration(i) * 48 * time.Hour)
+		require.NoError(t, db.Model(document).Update("created_at", createdAt).Error)
+		documents = append(documents, document)
+	}
+	driver.failingPaths[documents[0].Path] = true
+	driver.failingPaths[documents[1].Path] = true
+
+	handler := &documentretention.Handler{Dependency: &dependency.Dependency{
+		Config:            config,
+		Logger:            logger.New(logger.NewDevelopmentConfig()),
+		DBClient:          dbClient,
+		FileStorageClient: persistence.NewFileStoreService(driver),
+	}}
+	require.NoError(t, handler.Purge(ctx))
+
+	remaining, err := dbClient.Document.GetExpiredDocuments(ctx, &entity.Document{CategoryID: category.ID}, time.Now(), nil, 10)
+	require.NoError(t, err)
+	require.Len(t, remaining, 2)
+	assert.Equal(t, documents[0].ID, remaining[0].ID)
+	assert.Equal(t, documents[1].ID, remaining[1].ID)
+	assert.False(t, driver.HasObject(documents[2].Path))
+
+	var reports entity.DocumentPurgeReports
+	require.NoError(t, db.Find(&reports).Error)
+	require.Len(t, reports, 1)
+	assert.Equal(t, int64(1), reports[0].TotalPurged)
+	assert.Equal(t, int64(2), reports[0].TotalFailed)
+}
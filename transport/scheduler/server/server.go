@@ -0,0 +1,53 @@
+package server
+
+import (
+	"micro/persistence"
+	"micro/pkg/configurator"
+	"micro/pkg/logger"
+	"micro/pkg/scheduler"
+	"micro/pkg/util"
+	"micro/transport/scheduler/dependency"
+	"micro/transport/scheduler/job/documentretention"
+)
+
+// Server holds the dependency to initialize a new one.
+type Server struct {
+	config            *configurator.Config
+	logger            *logger.Logger
+	dbClient          *persistence.DBClient
+	fileStorageClient *persistence.FileStorageClient
+}
+
+// New will initialize a new Server.
+func New(options ...Option) *Server {
+	server := &Server{}
+
+	for _, opt := range options {
+		opt(server)
+	}
+
+	return server
+}
+
+// Init will start the Server.
+func (s *Server) Init() error {
+	dep := &dependency.Dependency{
+		Config:            s.config,
+		Logger:            s.logger,
+		DBClient:          s.dbClient,
+		FileStorageClient: s.fileStorageClient,
+	}
+
+	documentRetentionHandler := &documentretention.Handler{Dependency: dep}
+
+	jobScheduler := scheduler.New(scheduler.WithLogger(s.logger))
+
+	// register scheduler job
+	jobScheduler.Register(scheduler.Job{
+		Name:     "document:purge",
+		Interval: s.config.RetentionPurgeInterval,
+		Run:      documentRetentionHandler.Purge,
+	})
+
+	return util.RunSchedulerWithGracefulShutdown(jobScheduler, s.logger)
+}